@@ -0,0 +1,194 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type BaseToken struct {
+	client      *Client
+	networkType types.NetworkType
+	tokenType   types.TokenType
+	logger      *types.Logger
+	address     common.Address
+	tunnel      types.FxTunnelConfig
+}
+
+func newBaseToken(client *Client, address common.Address, networkType types.NetworkType, tokenType types.TokenType, tunnel types.FxTunnelConfig) *BaseToken {
+	return &BaseToken{
+		client:      client,
+		address:     address,
+		networkType: networkType,
+		tokenType:   tokenType,
+		tunnel:      tunnel,
+		logger:      types.NewLogger("fx-"+tokenType.String(), client.pos.Config().Debug),
+	}
+}
+
+func (token *BaseToken) Logger() *types.Logger { return token.logger }
+
+// TunnelAddress : root tunnel on root, child tunnel on child
+func (token *BaseToken) TunnelAddress() common.Address {
+	if token.networkType == types.Root {
+		return token.tunnel.RootTunnel
+	}
+	return token.tunnel.ChildTunnel
+}
+
+func (token *BaseToken) sendTransaction(ctx context.Context, to common.Address, data []byte, txOption *types.TxOption) (common.Hash, error) {
+	tx, err := txOption.SetTxData(to, data, big.NewInt(0)).Build(ctx, token.getClient())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = token.getClient().SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return tx.Hash(), nil
+}
+
+func (token *BaseToken) mapToken(ctx context.Context, rootTunnelAbi abi.ABI, txOption *types.TxOption) (common.Hash, error) {
+	if err := token.checkForRoot("MapToken"); err != nil {
+		return common.Hash{}, err
+	}
+	if err := token.checkTunnel(); err != nil {
+		return common.Hash{}, err
+	}
+
+	if token.tokenType.IsMintable() {
+		return common.Hash{}, fmt.Errorf("mintable token is mapped on child: %s", token.address)
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := rootTunnelAbi.Pack("mapToken", token.address)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return token.sendTransaction(ctx, token.tunnel.RootTunnel, data, txOption)
+}
+
+func (token *BaseToken) deposit(ctx context.Context, rootTunnelAbi abi.ABI, method string, txOption *types.TxOption, args ...interface{}) (common.Hash, error) {
+	token.logger.Debug(method, log.Fields{
+		"from":   txOption.From(),
+		"token":  token.address,
+		"tunnel": token.tunnel.RootTunnel,
+		"args":   args,
+	})
+
+	if err := token.checkTunnel(); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := rootTunnelAbi.Pack(method, append([]interface{}{token.address, txOption.From()}, args...)...)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return token.sendTransaction(ctx, token.tunnel.RootTunnel, data, txOption)
+}
+
+func (token *BaseToken) withdraw(ctx context.Context, childTunnelAbi abi.ABI, method string, txOption *types.TxOption, args ...interface{}) (common.Hash, error) {
+	token.logger.Debug(method, log.Fields{
+		"from":   txOption.From(),
+		"token":  token.address,
+		"tunnel": token.tunnel.ChildTunnel,
+		"args":   args,
+	})
+
+	if err := token.checkTunnel(); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := childTunnelAbi.Pack(method, append([]interface{}{token.address}, args...)...)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return token.sendTransaction(ctx, token.tunnel.ChildTunnel, data, txOption)
+}
+
+func (token *BaseToken) exit(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	token.logger.Debug("exit", log.Fields{
+		"txHash": txHash,
+		"tunnel": token.tunnel.RootTunnel,
+	})
+
+	if err := token.checkForRoot("Exit"); err != nil {
+		return common.Hash{}, err
+	}
+	if err := token.checkTunnel(); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	return token.client.ReceiveMessage(ctx, token.tunnel.RootTunnel, txHash, txOption)
+}
+
+func (token *BaseToken) childToken(ctx context.Context, rootTunnelAbi abi.ABI) (common.Address, error) {
+	if err := token.checkForRoot("ChildToken"); err != nil {
+		return common.Address{}, err
+	}
+	if err := token.checkTunnel(); err != nil {
+		return common.Address{}, err
+	}
+
+	rootToChildTokensResp, err := utils.CallContract(ctx, token.getClient(), token.tunnel.RootTunnel, rootTunnelAbi,
+		"rootToChildTokens",
+		token.address,
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	childToken := rootToChildTokensResp[0].(common.Address)
+	if childToken == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("token not mapped: %s", token.address)
+	}
+
+	return childToken, nil
+}
+
+func (token *BaseToken) getClient() types.IClient {
+	if token.networkType == types.Root {
+		return token.client.pos.Root
+	} else {
+		return token.client.pos.Child
+	}
+}
+
+func (token *BaseToken) checkForRoot(method string) error {
+	if token.networkType != types.Root {
+		return fmt.Errorf("allowed on root %s", method)
+	}
+	return nil
+}
+
+// checkTunnel : tunnel of the token type is deployed on the network
+func (token *BaseToken) checkTunnel() error {
+	if token.tunnel.IsZero() {
+		return fmt.Errorf("%s tunnel is not deployed on this network", token.tokenType)
+	}
+	return nil
+}
+
+func (token *BaseToken) checkForChild(method string) error {
+	if token.networkType != types.Child {
+		return fmt.Errorf("allowed on child %s", method)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/pos"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type Client struct {
+	pos    *pos.Client
+	config types.FxPortalConfig
+	logger *types.Logger
+}
+
+func NewDefaultConfig(network types.Network) (types.FxPortalConfig, error) {
+	contract, err := utils.GetContractByNetwork(network)
+	if err != nil {
+		return types.FxPortalConfig{}, err
+	}
+	return contract.FxPortalConfig(), nil
+}
+
+// NewClient : FxPortal client on top of pos client, root and child rpc clients are shared with pos client
+func NewClient(posClient *pos.Client, config types.FxPortalConfig) (*Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		pos:    posClient,
+		config: config,
+		logger: types.NewLogger("fx", posClient.Config().Debug),
+	}, nil
+}
+
+func (client *Client) Logger() *types.Logger { return client.logger }
+func (client *Client) POS() *pos.Client      { return client.pos }
+
+func (client *Client) ERC20(address common.Address, networkType types.NetworkType) *ERC20 {
+	return newERC20(client, address, networkType, types.ERC20, client.config.ERC20)
+}
+
+func (client *Client) MintableERC20(address common.Address, networkType types.NetworkType) *ERC20 {
+	return newERC20(client, address, networkType, types.MintableERC20, client.config.MintableERC20)
+}
+
+func (client *Client) ERC721(address common.Address, networkType types.NetworkType) *ERC721 {
+	return newERC721(client, address, networkType, types.ERC721, client.config.ERC721)
+}
+
+func (client *Client) MintableERC721(address common.Address, networkType types.NetworkType) *ERC721 {
+	return newERC721(client, address, networkType, types.MintableERC721, client.config.MintableERC721)
+}
+
+func (client *Client) ERC1155(address common.Address, networkType types.NetworkType) *ERC1155 {
+	return newERC1155(client, address, networkType, types.ERC1155, client.config.ERC1155)
+}
+
+func (client *Client) MintableERC1155(address common.Address, networkType types.NetworkType) *ERC1155 {
+	return newERC1155(client, address, networkType, types.MintableERC1155, client.config.MintableERC1155)
+}
+
+// SendMessageToChild : send message to receiver on child through FxRoot, receiver must implement processMessageFromRoot
+func (client *Client) SendMessageToChild(ctx context.Context, receiver common.Address, message []byte, txOption *types.TxOption) (common.Hash, error) {
+	client.Logger().Debug("SendMessageToChild", log.Fields{
+		"receiver": receiver,
+		"message":  hexutil.Encode(message),
+	})
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.FxRoot.Pack("sendMessageToChild", receiver, message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(client.config.FxRoot, data, big.NewInt(0)).Build(ctx, client.pos.Root)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = client.pos.Root.SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	client.Logger().Debug("SendMessageToChild", log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
+
+// BuildMessageProof : build receiveMessage input for MessageSent event emitted on child
+func (client *Client) BuildMessageProof(ctx context.Context, txHash common.Hash) ([]byte, error) {
+	client.Logger().Debug("BuildMessageProof", log.Fields{
+		"txHash": txHash,
+	})
+
+	checkPointed, err := client.pos.IsCheckPointed(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !checkPointed {
+		return nil, fmt.Errorf("not checkpointed tx: %s", txHash.String())
+	}
+
+	return client.pos.BuildPayloadForExit(ctx, txHash, types.FxMessageSent, 0)
+}
+
+// ReceiveMessage : deliver child to root message by calling receiveMessage on root tunnel with the proof of txHash
+func (client *Client) ReceiveMessage(ctx context.Context, rootTunnel common.Address, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	client.Logger().Debug("ReceiveMessage", log.Fields{
+		"rootTunnel": rootTunnel,
+		"txHash":     txHash,
+	})
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	proof, err := client.BuildMessageProof(ctx, txHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.FxRootTunnel.Pack("receiveMessage", proof)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(rootTunnel, data, big.NewInt(0)).Build(ctx, client.pos.Root)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = client.pos.Root.SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	client.Logger().Debug("ReceiveMessage", log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
+
+// ChildTunnel : child tunnel paired with root tunnel
+func (client *Client) ChildTunnel(ctx context.Context, rootTunnel common.Address) (common.Address, error) {
+	fxChildTunnelResp, err := utils.CallContract(ctx, client.pos.Root, rootTunnel, maticabi.FxRootTunnel,
+		"fxChildTunnel",
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return fxChildTunnelResp[0].(common.Address), nil
+}
+
+// RootTunnel : root tunnel paired with child tunnel
+func (client *Client) RootTunnel(ctx context.Context, childTunnel common.Address) (common.Address, error) {
+	fxRootTunnelResp, err := utils.CallContract(ctx, client.pos.Child, childTunnel, maticabi.FxChildTunnel,
+		"fxRootTunnel",
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return fxRootTunnelResp[0].(common.Address), nil
+}
@@ -0,0 +1,64 @@
+package fx
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/pos"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	TestPrivateKey, _ = crypto.HexToECDSA("1c28edecd1cdfbdb2e32c38d8e06ed042f3e31fb05d9884e5322376cce4706d4")
+	TestTxOption      = &types.TxOption{
+		PrivateKey: TestPrivateKey,
+		TxType:     types.DynamicFeeTxType,
+	}
+)
+
+func newTestClient(t *testing.T) *Client {
+	posClient, err := pos.NewClient(pos.NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	config, err := NewDefaultConfig(types.TestNet)
+	require.NoError(t, err)
+
+	client, err := NewClient(posClient, config)
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_SendMessageToChild(t *testing.T) {
+	client := newTestClient(t)
+
+	hash, err := client.SendMessageToChild(context.Background(), TestTxOption.From(), []byte("hello child"), TestTxOption)
+	assert.NoError(t, err)
+	t.Log("txHash", hash.String())
+}
+
+func TestClient_ChildTunnel(t *testing.T) {
+	client := newTestClient(t)
+	contract, err := utils.GetContractByNetwork(types.TestNet)
+	require.NoError(t, err)
+
+	childTunnel, err := client.ChildTunnel(context.Background(), common.HexToAddress(contract.Main.FxPortalContracts.FxERC20RootTunnel))
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress(contract.Matic.FxPortalContracts.FxERC20ChildTunnel), childTunnel)
+}
+
+func TestBaseToken_TunnelNotDeployed(t *testing.T) {
+	// fx tunnels are not part of the mainnet registry
+	posClient, err := pos.NewClient(pos.NewDefaultConfig(types.MainNet))
+	require.NoError(t, err)
+	config, err := NewDefaultConfig(types.MainNet)
+	require.NoError(t, err)
+	client, err := NewClient(posClient, config)
+	require.NoError(t, err)
+
+	_, err = client.ERC20(common.HexToAddress("0x01"), types.Root).ChildToken(context.Background())
+	assert.ErrorContains(t, err, "erc20 tunnel is not deployed")
+}
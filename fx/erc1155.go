@@ -0,0 +1,218 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type ERC1155 struct {
+	*BaseToken
+}
+
+func newERC1155(client *Client, address common.Address, networkType types.NetworkType, tokenType types.TokenType, tunnel types.FxTunnelConfig) *ERC1155 {
+	return &ERC1155{
+		BaseToken: newBaseToken(client, address, networkType, tokenType, tunnel),
+	}
+}
+
+// ApproveAll : approve all tokens to spender, when spender is zero address, approve to root tunnel
+func (erc1155 *ERC1155) ApproveAll(ctx context.Context, spender common.Address, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("ApproveAll", log.Fields{
+		"spender":  spender,
+		"contract": erc1155.address.String(),
+	})
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if spender == (common.Address{}) {
+		if err := erc1155.checkTunnel(); err != nil {
+			return common.Hash{}, err
+		}
+		spender = erc1155.tunnel.RootTunnel
+	}
+
+	data, err := maticabi.ERC1155.Pack("setApprovalForAll", spender, true)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return erc1155.sendTransaction(ctx, erc1155.address, data, txOption)
+}
+
+func (erc1155 *ERC1155) IsApprovedAll(ctx context.Context, address common.Address) (bool, error) {
+	erc1155.Logger().Debug("IsApprovedAll", log.Fields{
+		"address":  address,
+		"contract": erc1155.address,
+	})
+
+	if err := erc1155.checkForRoot("IsApprovedAll"); err != nil {
+		return false, err
+	}
+	if err := erc1155.checkTunnel(); err != nil {
+		return false, err
+	}
+
+	isApprovedForAllResp, err := utils.CallContract(ctx, erc1155.getClient(), erc1155.address, maticabi.ERC1155,
+		"isApprovedForAll",
+		address,
+		erc1155.tunnel.RootTunnel,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return isApprovedForAllResp[0].(bool), nil
+}
+
+// MapToken : map root token on root tunnel, child token is deployed when the mapping message is synced
+func (erc1155 *ERC1155) MapToken(ctx context.Context, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("MapToken", log.Fields{
+		"contract": erc1155.address.String(),
+	})
+
+	return erc1155.mapToken(ctx, maticabi.FxERC1155RootTunnel, txOption)
+}
+
+// ChildToken : child token mapped to root token on root tunnel
+func (erc1155 *ERC1155) ChildToken(ctx context.Context) (common.Address, error) {
+	return erc1155.childToken(ctx, maticabi.FxERC1155RootTunnel)
+}
+
+func (erc1155 *ERC1155) Deposit(ctx context.Context, tokenId, amount *big.Int, data []byte, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("Deposit", log.Fields{
+		"tokenId":  tokenId,
+		"amount":   amount,
+		"contract": erc1155.address.String(),
+	})
+	if err := erc1155.checkForRoot("Deposit"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if data == nil {
+		data = []byte{}
+	}
+
+	return erc1155.deposit(ctx, maticabi.FxERC1155RootTunnel, "deposit", txOption, tokenId, amount, data)
+}
+
+func (erc1155 *ERC1155) DepositMany(ctx context.Context, tokenIds, amounts []*big.Int, data []byte, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("DepositMany", log.Fields{
+		"tokenIds": tokenIds,
+		"amounts":  amounts,
+		"contract": erc1155.address.String(),
+	})
+	if err := erc1155.checkForRoot("DepositMany"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := erc1155.validateMany(tokenIds, amounts); err != nil {
+		return common.Hash{}, err
+	}
+
+	if data == nil {
+		data = []byte{}
+	}
+
+	return erc1155.deposit(ctx, maticabi.FxERC1155RootTunnel, "depositBatch", txOption, tokenIds, amounts, data)
+}
+
+func (erc1155 *ERC1155) validateMany(tokenIds, amounts []*big.Int) error {
+	if len(tokenIds) == 0 {
+		return fmt.Errorf("empty token ids")
+	}
+	if len(tokenIds) != len(amounts) {
+		return fmt.Errorf("token ids and amounts length mismatch: %d != %d", len(tokenIds), len(amounts))
+	}
+	return nil
+}
+
+func (erc1155 *ERC1155) Withdraw(ctx context.Context, tokenId, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("Withdraw", log.Fields{
+		"tokenId":  tokenId,
+		"amount":   amount,
+		"contract": erc1155.address,
+	})
+
+	if err := erc1155.checkForChild("Withdraw"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	return erc1155.withdraw(ctx, maticabi.FxERC1155ChildTunnel, "withdraw", txOption, tokenId, amount, []byte{})
+}
+
+func (erc1155 *ERC1155) WithdrawMany(ctx context.Context, tokenIds, amounts []*big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("WithdrawMany", log.Fields{
+		"tokenIds": tokenIds,
+		"amounts":  amounts,
+		"contract": erc1155.address,
+	})
+
+	if err := erc1155.checkForChild("WithdrawMany"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := erc1155.validateMany(tokenIds, amounts); err != nil {
+		return common.Hash{}, err
+	}
+
+	return erc1155.withdraw(ctx, maticabi.FxERC1155ChildTunnel, "withdrawBatch", txOption, tokenIds, amounts, []byte{})
+}
+
+func (erc1155 *ERC1155) Exit(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("Exit", log.Fields{
+		"txHash": txHash,
+	})
+
+	hash, err := erc1155.exit(ctx, txHash, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc1155.Logger().Debug("Exit", log.Fields{
+		"txHash": hash,
+	})
+	return hash, nil
+}
+
+// ExitMany : batch withdraw emits a single message, same as Exit
+func (erc1155 *ERC1155) ExitMany(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	return erc1155.Exit(ctx, txHash, txOption)
+}
+
+func (erc1155 *ERC1155) BalanceOf(ctx context.Context, address common.Address, tokenId *big.Int) (*big.Int, error) {
+	balanceOfResp, err := utils.CallContract(ctx, erc1155.getClient(), erc1155.address, maticabi.ERC1155, "balanceOf", address, tokenId)
+	if err != nil {
+		return nil, err
+	}
+	balance := balanceOfResp[0].(*big.Int)
+
+	erc1155.Logger().Debug("BalanceOf", log.Fields{
+		"balance": balance,
+	})
+
+	return balance, nil
+}
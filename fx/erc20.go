@@ -0,0 +1,167 @@
+package fx
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type ERC20 struct {
+	*BaseToken
+}
+
+func newERC20(client *Client, address common.Address, networkType types.NetworkType, tokenType types.TokenType, tunnel types.FxTunnelConfig) *ERC20 {
+	return &ERC20{
+		BaseToken: newBaseToken(client, address, networkType, tokenType, tunnel),
+	}
+}
+
+// Approve : approve to spender, when spender is zero address, approve to root tunnel
+func (erc20 *ERC20) Approve(ctx context.Context, spender common.Address, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("Approve", log.Fields{
+		"amount":   amount,
+		"contract": erc20.address.String(),
+	})
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if spender == (common.Address{}) {
+		if err := erc20.checkTunnel(); err != nil {
+			return common.Hash{}, err
+		}
+		spender = erc20.tunnel.RootTunnel
+	}
+
+	data, err := maticabi.ERC20.Pack("approve", spender, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash, err := erc20.sendTransaction(ctx, erc20.address, data, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc20.Logger().Debug("Approve", log.Fields{
+		"txHash": txHash,
+	})
+	return txHash, nil
+}
+
+// ApproveMax : approve max to spender, when spender is zero address, approve to root tunnel
+func (erc20 *ERC20) ApproveMax(ctx context.Context, spender common.Address, txOption *types.TxOption) (common.Hash, error) {
+	amount, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+	return erc20.Approve(ctx, spender, amount, txOption)
+}
+
+func (erc20 *ERC20) Allowance(ctx context.Context, owner, spender common.Address) (*big.Int, error) {
+	allowanceResp, err := utils.CallContract(ctx, erc20.getClient(), erc20.address, maticabi.ERC20, "allowance", owner, spender)
+	if err != nil {
+		return nil, err
+	}
+	allowance := allowanceResp[0].(*big.Int)
+
+	erc20.Logger().Debug("Allowance", log.Fields{
+		"allowance": allowance,
+	})
+
+	return allowance, nil
+}
+
+// MapToken : map root token on root tunnel, child token is deployed when the mapping message is synced
+func (erc20 *ERC20) MapToken(ctx context.Context, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("MapToken", log.Fields{
+		"contract": erc20.address.String(),
+	})
+
+	return erc20.mapToken(ctx, maticabi.FxERC20RootTunnel, txOption)
+}
+
+// ChildToken : child token mapped to root token on root tunnel
+func (erc20 *ERC20) ChildToken(ctx context.Context) (common.Address, error) {
+	return erc20.childToken(ctx, maticabi.FxERC20RootTunnel)
+}
+
+func (erc20 *ERC20) Deposit(ctx context.Context, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("Deposit", log.Fields{
+		"amount":   amount,
+		"contract": erc20.address.String(),
+	})
+	if err := erc20.checkForRoot("Deposit"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash, err := erc20.deposit(ctx, maticabi.FxERC20RootTunnel, "deposit", txOption, amount, []byte{})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc20.Logger().Debug("Deposit", log.Fields{
+		"txHash": txHash,
+	})
+	return txHash, nil
+}
+
+func (erc20 *ERC20) Withdraw(ctx context.Context, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("Withdraw", log.Fields{
+		"amount":   amount,
+		"contract": erc20.address.String(),
+	})
+	if err := erc20.checkForChild("Withdraw"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash, err := erc20.withdraw(ctx, maticabi.FxERC20ChildTunnel, "withdraw", txOption, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc20.Logger().Debug("Withdraw", log.Fields{
+		"txHash": txHash,
+	})
+	return txHash, nil
+}
+
+func (erc20 *ERC20) Exit(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("Exit", log.Fields{
+		"txHash":   txHash.String(),
+		"contract": erc20.address.String(),
+	})
+
+	hash, err := erc20.exit(ctx, txHash, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc20.Logger().Debug("Exit", log.Fields{
+		"txHash": hash,
+	})
+	return hash, nil
+}
+
+func (erc20 *ERC20) BalanceOf(ctx context.Context, address common.Address) (*big.Int, error) {
+	balanceOfResp, err := utils.CallContract(ctx, erc20.getClient(), erc20.address, maticabi.ERC20, "balanceOf", address)
+	if err != nil {
+		return nil, err
+	}
+	balance := balanceOfResp[0].(*big.Int)
+
+	erc20.Logger().Debug("BalanceOf", log.Fields{
+		"balance": balance,
+	})
+
+	return balance, nil
+}
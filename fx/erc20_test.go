@@ -0,0 +1,47 @@
+package fx
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func TestERC20_Deposit(t *testing.T) {
+	client := newTestClient(t)
+	contract, err := utils.GetContractByNetwork(types.TestNet)
+	require.NoError(t, err)
+
+	erc20 := client.ERC20(common.HexToAddress(contract.Main.FxPortalContracts.Tokens.FxERC20Root), types.Root)
+	hash, err := erc20.Deposit(context.Background(), big.NewInt(123456789), TestTxOption)
+	assert.NoError(t, err)
+	t.Log("txHash", hash.String())
+}
+
+func TestERC20_ChildToken(t *testing.T) {
+	client := newTestClient(t)
+	contract, err := utils.GetContractByNetwork(types.TestNet)
+	require.NoError(t, err)
+
+	childToken, err := client.ERC20(common.HexToAddress(contract.Main.FxPortalContracts.Tokens.FxERC20Root), types.Root).ChildToken(context.Background())
+	assert.NoError(t, err)
+	t.Log("childToken", childToken)
+}
+
+func TestERC20_MapTokenMintable(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.MintableERC20(common.Address{}, types.Root).MapToken(context.Background(), TestTxOption)
+	assert.Error(t, err)
+}
+
+func TestERC20_WithdrawOnRoot(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.ERC20(common.Address{}, types.Root).Withdraw(context.Background(), big.NewInt(1), TestTxOption)
+	assert.Error(t, err)
+}
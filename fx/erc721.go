@@ -0,0 +1,192 @@
+package fx
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type ERC721 struct {
+	*BaseToken
+}
+
+func newERC721(client *Client, address common.Address, networkType types.NetworkType, tokenType types.TokenType, tunnel types.FxTunnelConfig) *ERC721 {
+	return &ERC721{
+		BaseToken: newBaseToken(client, address, networkType, tokenType, tunnel),
+	}
+}
+
+// Approve : approve token to spender, when spender is zero address, approve to root tunnel
+func (erc721 *ERC721) Approve(ctx context.Context, spender common.Address, tokenId *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc721.Logger().Debug("Approve", log.Fields{
+		"tokenId":  tokenId,
+		"spender":  spender,
+		"contract": erc721.address.String(),
+	})
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if spender == (common.Address{}) {
+		if err := erc721.checkTunnel(); err != nil {
+			return common.Hash{}, err
+		}
+		spender = erc721.tunnel.RootTunnel
+	}
+
+	data, err := maticabi.ERC721.Pack("approve", spender, tokenId)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return erc721.sendTransaction(ctx, erc721.address, data, txOption)
+}
+
+// ApproveAll : approve all tokens to spender, when spender is zero address, approve to root tunnel
+func (erc721 *ERC721) ApproveAll(ctx context.Context, spender common.Address, txOption *types.TxOption) (common.Hash, error) {
+	erc721.Logger().Debug("ApproveAll", log.Fields{
+		"spender":  spender,
+		"contract": erc721.address.String(),
+	})
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if spender == (common.Address{}) {
+		if err := erc721.checkTunnel(); err != nil {
+			return common.Hash{}, err
+		}
+		spender = erc721.tunnel.RootTunnel
+	}
+
+	data, err := maticabi.ERC721.Pack("setApprovalForAll", spender, true)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return erc721.sendTransaction(ctx, erc721.address, data, txOption)
+}
+
+func (erc721 *ERC721) IsApprovedAll(ctx context.Context, address common.Address) (bool, error) {
+	erc721.Logger().Debug("IsApprovedAll", log.Fields{
+		"address":  address,
+		"contract": erc721.address,
+	})
+
+	if err := erc721.checkForRoot("IsApprovedAll"); err != nil {
+		return false, err
+	}
+	if err := erc721.checkTunnel(); err != nil {
+		return false, err
+	}
+
+	isApprovedForAllResp, err := utils.CallContract(ctx, erc721.getClient(), erc721.address, maticabi.ERC721,
+		"isApprovedForAll",
+		address,
+		erc721.tunnel.RootTunnel,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return isApprovedForAllResp[0].(bool), nil
+}
+
+// MapToken : map root token on root tunnel, child token is deployed when the mapping message is synced
+func (erc721 *ERC721) MapToken(ctx context.Context, txOption *types.TxOption) (common.Hash, error) {
+	erc721.Logger().Debug("MapToken", log.Fields{
+		"contract": erc721.address.String(),
+	})
+
+	return erc721.mapToken(ctx, maticabi.FxERC721RootTunnel, txOption)
+}
+
+// ChildToken : child token mapped to root token on root tunnel
+func (erc721 *ERC721) ChildToken(ctx context.Context) (common.Address, error) {
+	return erc721.childToken(ctx, maticabi.FxERC721RootTunnel)
+}
+
+func (erc721 *ERC721) Deposit(ctx context.Context, tokenId *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc721.Logger().Debug("Deposit", log.Fields{
+		"tokenId":  tokenId,
+		"contract": erc721.address.String(),
+	})
+	if err := erc721.checkForRoot("Deposit"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash, err := erc721.deposit(ctx, maticabi.FxERC721RootTunnel, "deposit", txOption, tokenId, []byte{})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc721.Logger().Debug("Deposit", log.Fields{
+		"txHash": txHash,
+	})
+	return txHash, nil
+}
+
+func (erc721 *ERC721) Withdraw(ctx context.Context, tokenId *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc721.Logger().Debug("Withdraw", log.Fields{
+		"tokenId":  tokenId,
+		"contract": erc721.address,
+	})
+
+	if err := erc721.checkForChild("Withdraw"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash, err := erc721.withdraw(ctx, maticabi.FxERC721ChildTunnel, "withdraw", txOption, tokenId, []byte{})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc721.Logger().Debug("Withdraw", log.Fields{
+		"txHash": txHash,
+	})
+	return txHash, nil
+}
+
+func (erc721 *ERC721) Exit(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	erc721.Logger().Debug("Exit", log.Fields{
+		"txHash": txHash,
+	})
+
+	hash, err := erc721.exit(ctx, txHash, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc721.Logger().Debug("Exit", log.Fields{
+		"txHash": hash,
+	})
+	return hash, nil
+}
+
+func (erc721 *ERC721) BalanceOf(ctx context.Context, address common.Address) (*big.Int, error) {
+	balanceOfResp, err := utils.CallContract(ctx, erc721.getClient(), erc721.address, maticabi.ERC721, "balanceOf", address)
+	if err != nil {
+		return nil, err
+	}
+	balance := balanceOfResp[0].(*big.Int)
+
+	erc721.Logger().Debug("BalanceOf", log.Fields{
+		"balance": balance,
+	})
+
+	return balance, nil
+}
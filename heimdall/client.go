@@ -0,0 +1,238 @@
+package heimdall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Client struct {
+	config types.HeimdallConfig
+	http   *http.Client
+	logger *types.Logger
+}
+
+func NewDefaultConfig(network types.Network) (types.HeimdallConfig, error) {
+	contract, err := utils.GetContractByNetwork(network)
+	if err != nil {
+		return types.HeimdallConfig{}, err
+	}
+	return contract.HeimdallConfig(types.DebugConfig{
+		Enable: true,
+		Level:  types.DebugLevel,
+	}), nil
+}
+
+func NewClient(config types.HeimdallConfig) *Client {
+	return &Client{
+		config: config,
+		http:   http.DefaultClient,
+		logger: types.NewLogger("heimdall", config.Debug),
+	}
+}
+
+// WithHTTPClient : replace default http client, e.g. to set timeouts or transport
+func (client *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	client.http = httpClient
+	return client
+}
+
+func (client *Client) Logger() *types.Logger { return client.logger }
+
+func (client *Client) LatestCheckpoint(ctx context.Context) (Checkpoint, error) {
+	var checkpoint Checkpoint
+	if err := client.get(ctx, "/checkpoints/latest", nil, &checkpoint); err != nil {
+		return Checkpoint{}, err
+	}
+
+	return checkpoint, nil
+}
+
+func (client *Client) Checkpoint(ctx context.Context, number uint64) (Checkpoint, error) {
+	var checkpoint Checkpoint
+	if err := client.get(ctx, fmt.Sprintf("/checkpoints/%d", number), nil, &checkpoint); err != nil {
+		return Checkpoint{}, err
+	}
+
+	if checkpoint.ID == 0 {
+		checkpoint.ID = number
+	}
+	return checkpoint, nil
+}
+
+func (client *Client) CheckpointCount(ctx context.Context) (uint64, error) {
+	var count struct {
+		Result uint64 `json:"result"`
+	}
+	if err := client.get(ctx, "/checkpoints/count", nil, &count); err != nil {
+		return 0, err
+	}
+
+	return count.Result, nil
+}
+
+// CheckpointForBlock : binary search on heimdall checkpoints to find the checkpoint containing child block,
+// root header block number of the checkpoint is id * checkpoint interval
+func (client *Client) CheckpointForBlock(ctx context.Context, blockNumber uint64) (Checkpoint, error) {
+	client.Logger().Debug("CheckpointForBlock", log.Fields{
+		"blockNumber": blockNumber,
+	})
+
+	count, err := client.CheckpointCount(ctx)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+
+	start, end := uint64(1), count
+	for start <= end {
+		mid := (start + end) / 2
+		checkpoint, err := client.Checkpoint(ctx, mid)
+		if err != nil {
+			return Checkpoint{}, err
+		}
+
+		if blockNumber < checkpoint.StartBlock {
+			end = mid - 1
+		} else if blockNumber > checkpoint.EndBlock {
+			start = mid + 1
+		} else {
+			return checkpoint, nil
+		}
+	}
+
+	return Checkpoint{}, fmt.Errorf("block not checkpointed: %d", blockNumber)
+}
+
+func (client *Client) LatestSpan(ctx context.Context) (Span, error) {
+	var span Span
+	if err := client.get(ctx, "/bor/latest-span", nil, &span); err != nil {
+		return Span{}, err
+	}
+
+	return span, nil
+}
+
+func (client *Client) Span(ctx context.Context, id uint64) (Span, error) {
+	var span Span
+	if err := client.get(ctx, fmt.Sprintf("/bor/span/%d", id), nil, &span); err != nil {
+		return Span{}, err
+	}
+
+	return span, nil
+}
+
+func (client *Client) ValidatorSet(ctx context.Context) (ValidatorSet, error) {
+	var validatorSet ValidatorSet
+	if err := client.get(ctx, "/staking/validator-set", nil, &validatorSet); err != nil {
+		return ValidatorSet{}, err
+	}
+
+	return validatorSet, nil
+}
+
+func (client *Client) LatestMilestone(ctx context.Context) (Milestone, error) {
+	var milestone Milestone
+	if err := client.get(ctx, "/milestone/latest", nil, &milestone); err != nil {
+		return Milestone{}, err
+	}
+
+	return milestone, nil
+}
+
+func (client *Client) Milestone(ctx context.Context, number uint64) (Milestone, error) {
+	var milestone Milestone
+	if err := client.get(ctx, fmt.Sprintf("/milestone/%d", number), nil, &milestone); err != nil {
+		return Milestone{}, err
+	}
+
+	return milestone, nil
+}
+
+func (client *Client) MilestoneCount(ctx context.Context) (uint64, error) {
+	var count struct {
+		Count uint64 `json:"count"`
+	}
+	if err := client.get(ctx, "/milestone/count", nil, &count); err != nil {
+		return 0, err
+	}
+
+	return count.Count, nil
+}
+
+func (client *Client) EventRecord(ctx context.Context, id uint64) (EventRecord, error) {
+	var record EventRecord
+	if err := client.get(ctx, fmt.Sprintf("/clerk/event-record/%d", id), nil, &record); err != nil {
+		return EventRecord{}, err
+	}
+
+	return record, nil
+}
+
+// EventRecords : state sync records from fromId committed before toTime
+func (client *Client) EventRecords(ctx context.Context, fromId uint64, toTime time.Time, limit int) ([]EventRecord, error) {
+	var records []EventRecord
+	params := map[string]string{
+		"from-id": strconv.FormatUint(fromId, 10),
+		"to-time": strconv.FormatInt(toTime.Unix(), 10),
+		"limit":   strconv.Itoa(limit),
+	}
+	if err := client.get(ctx, "/clerk/event-record/list", params, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (client *Client) get(ctx context.Context, path string, params map[string]string, result interface{}) error {
+	endpoint := strings.TrimRight(client.config.API, "/") + path
+	client.Logger().Debug("Get", log.Fields{
+		"url":    endpoint,
+		"params": params,
+	})
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	for key, value := range params {
+		query.Add(key, value)
+	}
+	request.URL.RawQuery = query.Encode()
+
+	response, err := client.http.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	var resp apiResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("heimdall %s: status %d: %w", path, response.StatusCode, err)
+	}
+
+	if response.StatusCode != http.StatusOK || len(resp.Error) > 0 {
+		return fmt.Errorf("heimdall %s: status %d: %s", path, response.StatusCode, string(resp.Error))
+	}
+
+	if len(resp.Result) == 0 || string(resp.Result) == "null" {
+		return fmt.Errorf("heimdall %s: empty result", path)
+	}
+
+	return json.Unmarshal(resp.Result, result)
+}
@@ -0,0 +1,109 @@
+package heimdall
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var result string
+		switch {
+		case r.URL.Path == "/checkpoints/latest":
+			result = `{"id":3,"proposer":"0x1c4f0f054a0d6a1415382dc0fd83c6535188b220","start_block":200,"end_block":299,"root_hash":"0x0b5d8f7c1ad56dc5c8b4e5f77c8b1dd8c2e5d2c94a8a9dc0e2f68d7f3f8f6a11","bor_chain_id":"80001","timestamp":1670000000}`
+		case r.URL.Path == "/checkpoints/count":
+			result = `{"result":3}`
+		case strings.HasPrefix(r.URL.Path, "/checkpoints/"):
+			var number uint64
+			fmt.Sscanf(r.URL.Path, "/checkpoints/%d", &number)
+			result = fmt.Sprintf(`{"proposer":"0x1c4f0f054a0d6a1415382dc0fd83c6535188b220","start_block":%d,"end_block":%d,"root_hash":"0x0b5d8f7c1ad56dc5c8b4e5f77c8b1dd8c2e5d2c94a8a9dc0e2f68d7f3f8f6a11","bor_chain_id":"80001","timestamp":1670000000}`, (number-1)*100, number*100-1)
+		case r.URL.Path == "/bor/span/1":
+			result = `{"span_id":1,"start_block":256,"end_block":6655,"validator_set":{"validators":[{"ID":1,"power":10000,"signer":"0x1c4f0f054a0d6a1415382dc0fd83c6535188b220"}],"proposer":{"ID":1,"signer":"0x1c4f0f054a0d6a1415382dc0fd83c6535188b220"}},"selected_producers":[{"ID":1}],"bor_chain_id":"80001"}`
+		case r.URL.Path == "/milestone/count":
+			result = `{"count":42}`
+		case r.URL.Path == "/clerk/event-record/list":
+			assert.Equal(t, "10", r.URL.Query().Get("from-id"))
+			result = `[{"id":10,"contract":"0x8397259c983751daf40400790063935a11afa28a","data":"0x1234","tx_hash":"0x7a3f5ab3c1f1b4c7d0d6d0a0e3a4b1c6d9e2f7a8b3c4d5e6f7a8b9c0d1e2f3a4","log_index":3,"bor_chain_id":"80001","record_time":"2022-12-02T10:00:00Z"}]`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"height":"100","result":%s}`, result)
+	}))
+}
+
+func newTestClient(server *httptest.Server) *Client {
+	return NewClient(types.HeimdallConfig{API: server.URL})
+}
+
+func TestClient_LatestCheckpoint(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	checkpoint, err := newTestClient(server).LatestCheckpoint(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), checkpoint.ID)
+	assert.Equal(t, uint64(299), checkpoint.EndBlock)
+	assert.Equal(t, common.HexToAddress("0x1c4f0f054a0d6a1415382dc0fd83c6535188b220"), checkpoint.Proposer)
+}
+
+func TestClient_CheckpointForBlock(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	client := newTestClient(server)
+	checkpoint, err := client.CheckpointForBlock(context.Background(), 150)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), checkpoint.ID)
+
+	_, err = client.CheckpointForBlock(context.Background(), 1000)
+	assert.Error(t, err)
+}
+
+func TestClient_Span(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	span, err := newTestClient(server).Span(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6655), span.EndBlock)
+	assert.Len(t, span.ValidatorSet.Validators, 1)
+	assert.Equal(t, int64(10000), span.ValidatorSet.Validators[0].VotingPower)
+}
+
+func TestClient_MilestoneCount(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	count, err := newTestClient(server).MilestoneCount(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), count)
+}
+
+func TestClient_EventRecords(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	records, err := newTestClient(server).EventRecords(context.Background(), 10, time.Now(), 50)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, uint64(10), records[0].ID)
+	assert.Equal(t, []byte{0x12, 0x34}, []byte(records[0].Data))
+}
+
+func TestClient_NotFound(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	_, err := newTestClient(server).EventRecord(context.Background(), 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package heimdall
+
+import (
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"time"
+)
+
+type apiResponse struct {
+	Height string          `json:"height"`
+	Result json.RawMessage `json:"result"`
+	Error  json.RawMessage `json:"error"`
+}
+
+type Checkpoint struct {
+	ID         uint64         `json:"id"`
+	Proposer   common.Address `json:"proposer"`
+	StartBlock uint64         `json:"start_block"`
+	EndBlock   uint64         `json:"end_block"`
+	RootHash   common.Hash    `json:"root_hash"`
+	BorChainID string         `json:"bor_chain_id"`
+	Timestamp  uint64         `json:"timestamp"`
+}
+
+type Validator struct {
+	ID               uint64         `json:"ID"`
+	StartEpoch       uint64         `json:"startEpoch"`
+	EndEpoch         uint64         `json:"endEpoch"`
+	Nonce            uint64         `json:"nonce"`
+	VotingPower      int64          `json:"power"`
+	PubKey           string         `json:"pubKey"`
+	Signer           common.Address `json:"signer"`
+	LastUpdated      string         `json:"last_updated"`
+	Jailed           bool           `json:"jailed"`
+	ProposerPriority int64          `json:"accum"`
+}
+
+type ValidatorSet struct {
+	Validators []Validator `json:"validators"`
+	Proposer   Validator   `json:"proposer"`
+}
+
+type Span struct {
+	ID                uint64       `json:"span_id"`
+	StartBlock        uint64       `json:"start_block"`
+	EndBlock          uint64       `json:"end_block"`
+	ValidatorSet      ValidatorSet `json:"validator_set"`
+	SelectedProducers []Validator  `json:"selected_producers"`
+	BorChainID        string       `json:"bor_chain_id"`
+}
+
+type Milestone struct {
+	ID         string         `json:"milestone_id"`
+	Proposer   common.Address `json:"proposer"`
+	StartBlock uint64         `json:"start_block"`
+	EndBlock   uint64         `json:"end_block"`
+	Hash       common.Hash    `json:"hash"`
+	BorChainID string         `json:"bor_chain_id"`
+	Timestamp  uint64         `json:"timestamp"`
+}
+
+// EventRecord : clerk state sync record, id matches StateSynced id on root
+type EventRecord struct {
+	ID         uint64         `json:"id"`
+	Contract   common.Address `json:"contract"`
+	Data       hexutil.Bytes  `json:"data"`
+	TxHash     common.Hash    `json:"tx_hash"`
+	LogIndex   uint64         `json:"log_index"`
+	BorChainID string         `json:"bor_chain_id"`
+	RecordTime time.Time      `json:"record_time"`
+}
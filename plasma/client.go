@@ -0,0 +1,182 @@
+package plasma
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/pos"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type Client struct {
+	pos    *pos.Client
+	config types.PlasmaConfig
+	logger *types.Logger
+}
+
+func NewDefaultConfig(network types.Network) (types.PlasmaConfig, error) {
+	contract, err := utils.GetContractByNetwork(network)
+	if err != nil {
+		return types.PlasmaConfig{}, err
+	}
+	return contract.PlasmaConfig(), nil
+}
+
+// NewClient : plasma bridge client on top of pos client, root and child rpc clients are shared with pos client
+func NewClient(posClient *pos.Client, config types.PlasmaConfig) (*Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		pos:    posClient,
+		config: config,
+		logger: types.NewLogger("plasma", posClient.Config().Debug),
+	}, nil
+}
+
+func (client *Client) Logger() *types.Logger { return client.logger }
+func (client *Client) POS() *pos.Client      { return client.pos }
+
+func (client *Client) ERC20(address common.Address, networkType types.NetworkType) *ERC20 {
+	return newERC20(client, address, networkType)
+}
+
+// Matic : native matic token, MaticToken on root and 0x…1010 on child
+func (client *Client) Matic(networkType types.NetworkType) *ERC20 {
+	if networkType == types.Root {
+		return newERC20(client, client.config.MaticToken, networkType)
+	}
+	return newERC20(client, common.HexToAddress(types.MaticAddress), networkType)
+}
+
+// ProcessExits : process exits of root token whose challenge period is over
+func (client *Client) ProcessExits(ctx context.Context, rootToken common.Address, txOption *types.TxOption) (common.Hash, error) {
+	client.Logger().Debug("ProcessExits", log.Fields{
+		"token": rootToken,
+	})
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.WithdrawManager.Pack("processExits", rootToken)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(client.config.WithdrawManager, data, big.NewInt(0)).Build(ctx, client.pos.Root)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = client.pos.Root.SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	client.Logger().Debug("ProcessExits", log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
+
+// ExitId : exit id from ExitStarted event of start exit tx
+func (client *Client) ExitId(ctx context.Context, startExitTxHash common.Hash) (*big.Int, error) {
+	receipt, err := client.pos.Root.TransactionReceipt(ctx, startExitTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, receiptLog := range receipt.Logs {
+		if receiptLog.Address != client.config.WithdrawManager || len(receiptLog.Topics) < 4 {
+			continue
+		}
+
+		if receiptLog.Topics[0] == common.HexToHash(types.PlasmaExitStarted) {
+			return receiptLog.Topics[2].Big(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("not found ExitStarted event: %s", startExitTxHash)
+}
+
+// IsExitPending : exit nft exists until the exit is processed
+func (client *Client) IsExitPending(ctx context.Context, exitId *big.Int) (bool, error) {
+	existsResp, err := utils.CallContract(ctx, client.pos.Root, client.config.ExitNFT, maticabi.ExitNFT,
+		"exists",
+		exitId,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return existsResp[0].(bool), nil
+}
+
+// ExitableAt : unix time when the exit can be processed,
+// max(checkpoint createdAt + 2 * HALF_EXIT_PERIOD, start exit time + HALF_EXIT_PERIOD)
+func (client *Client) ExitableAt(ctx context.Context, burnTxHash, startExitTxHash common.Hash) (*big.Int, error) {
+	client.Logger().Debug("ExitableAt", log.Fields{
+		"burnTxHash":      burnTxHash,
+		"startExitTxHash": startExitTxHash,
+	})
+
+	halfExitPeriodResp, err := utils.CallContract(ctx, client.pos.Root, client.config.WithdrawManager, maticabi.WithdrawManager,
+		"HALF_EXIT_PERIOD",
+	)
+	if err != nil {
+		return nil, err
+	}
+	halfExitPeriod := new(big.Int).SetUint64(uint64(halfExitPeriodResp[0].(uint32)))
+
+	burnReceipt, err := client.pos.Child.TransactionReceipt(ctx, burnTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blockInfo, err := client.pos.Root.GetRootBlockInfo(ctx, burnReceipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	startExitReceipt, err := client.pos.Root.TransactionReceipt(ctx, startExitTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	startExitBlock, err := client.pos.Root.HeaderByNumber(ctx, startExitReceipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	exitableAt := new(big.Int).Add(blockInfo.CreatedAt, new(big.Int).Mul(halfExitPeriod, big.NewInt(2)))
+	startExitableAt := new(big.Int).Add(new(big.Int).SetUint64(startExitBlock.Time), halfExitPeriod)
+	if startExitableAt.Cmp(exitableAt) == 1 {
+		exitableAt = startExitableAt
+	}
+
+	client.Logger().Debug("ExitableAt", log.Fields{
+		"exitableAt": exitableAt,
+	})
+	return exitableAt, nil
+}
+
+// IsExitable : challenge period of the exit is over
+func (client *Client) IsExitable(ctx context.Context, burnTxHash, startExitTxHash common.Hash) (bool, error) {
+	exitableAt, err := client.ExitableAt(ctx, burnTxHash, startExitTxHash)
+	if err != nil {
+		return false, err
+	}
+
+	latest, err := client.pos.Root.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return exitableAt.Cmp(new(big.Int).SetUint64(latest.Time)) <= 0, nil
+}
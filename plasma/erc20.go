@@ -0,0 +1,230 @@
+package plasma
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type ERC20 struct {
+	client      *Client
+	networkType types.NetworkType
+	logger      *types.Logger
+	address     common.Address
+}
+
+func newERC20(client *Client, address common.Address, networkType types.NetworkType) *ERC20 {
+	return &ERC20{
+		client:      client,
+		address:     address,
+		networkType: networkType,
+		logger:      types.NewLogger("plasma-erc20", client.pos.Config().Debug),
+	}
+}
+
+func (erc20 *ERC20) Logger() *types.Logger { return erc20.logger }
+
+// Approve : approve to spender, when spender is zero address, approve to deposit manager
+func (erc20 *ERC20) Approve(ctx context.Context, spender common.Address, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("Approve", log.Fields{
+		"amount":   amount,
+		"contract": erc20.address.String(),
+	})
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if spender == (common.Address{}) {
+		spender = erc20.client.config.DepositManager
+	}
+
+	data, err := maticabi.ERC20.Pack("approve", spender, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return erc20.sendTransaction(ctx, erc20.address, data, big.NewInt(0), txOption)
+}
+
+func (erc20 *ERC20) Allowance(ctx context.Context, owner, spender common.Address) (*big.Int, error) {
+	allowanceResp, err := utils.CallContract(ctx, erc20.getClient(), erc20.address, maticabi.ERC20, "allowance", owner, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	return allowanceResp[0].(*big.Int), nil
+}
+
+// Deposit : deposit through DepositManager, tokens must be approved to deposit manager
+func (erc20 *ERC20) Deposit(ctx context.Context, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("Deposit", log.Fields{
+		"amount":   amount,
+		"contract": erc20.address.String(),
+	})
+	if err := erc20.checkForRoot("Deposit"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.DepositManager.Pack("depositERC20ForUser", erc20.address, txOption.From(), amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash, err := erc20.sendTransaction(ctx, erc20.client.config.DepositManager, data, big.NewInt(0), txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc20.Logger().Debug("Deposit", log.Fields{
+		"txHash": txHash,
+	})
+	return txHash, nil
+}
+
+// Withdraw : burn tokens on child, the burn tx is used for StartExit after checkpoint
+func (erc20 *ERC20) Withdraw(ctx context.Context, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("Withdraw", log.Fields{
+		"amount":   amount,
+		"contract": erc20.address.String(),
+	})
+	if err := erc20.checkForChild("Withdraw"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.ERC20.Pack("withdraw", amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	value := big.NewInt(0)
+	if erc20.address == common.HexToAddress(types.MaticAddress) {
+		value = amount
+	}
+
+	txHash, err := erc20.sendTransaction(ctx, erc20.address, data, value, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc20.Logger().Debug("Withdraw", log.Fields{
+		"txHash": txHash,
+	})
+	return txHash, nil
+}
+
+// StartExit : call startExitWithBurntTokens on plasma ERC20Predicate with the proof of burn tx,
+// the exit can be processed after the challenge period
+func (erc20 *ERC20) StartExit(ctx context.Context, burnTxHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	erc20.Logger().Debug("StartExit", log.Fields{
+		"burnTxHash": burnTxHash,
+		"contract":   erc20.address.String(),
+	})
+	if err := erc20.checkForRoot("StartExit"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	checkPointed, err := erc20.client.pos.IsCheckPointed(ctx, burnTxHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if !checkPointed {
+		return common.Hash{}, fmt.Errorf("not checkpointed tx: %s", burnTxHash.String())
+	}
+
+	payload, err := erc20.client.pos.BuildPayloadForExit(ctx, burnTxHash, types.PlasmaWithdraw, 0)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.PlasmaERC20Predicate.Pack("startExitWithBurntTokens", payload)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash, err := erc20.sendTransaction(ctx, erc20.client.config.ERC20Predicate, data, big.NewInt(0), txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc20.Logger().Debug("StartExit", log.Fields{
+		"txHash": txHash,
+	})
+	return txHash, nil
+}
+
+// ProcessExits : process exits of the token whose challenge period is over
+func (erc20 *ERC20) ProcessExits(ctx context.Context, txOption *types.TxOption) (common.Hash, error) {
+	if err := erc20.checkForRoot("ProcessExits"); err != nil {
+		return common.Hash{}, err
+	}
+
+	return erc20.client.ProcessExits(ctx, erc20.address, txOption)
+}
+
+func (erc20 *ERC20) BalanceOf(ctx context.Context, address common.Address) (*big.Int, error) {
+	balanceOfResp, err := utils.CallContract(ctx, erc20.getClient(), erc20.address, maticabi.ERC20, "balanceOf", address)
+	if err != nil {
+		return nil, err
+	}
+	balance := balanceOfResp[0].(*big.Int)
+
+	erc20.Logger().Debug("BalanceOf", log.Fields{
+		"balance": balance,
+	})
+
+	return balance, nil
+}
+
+func (erc20 *ERC20) sendTransaction(ctx context.Context, to common.Address, data []byte, value *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	tx, err := txOption.SetTxData(to, data, value).Build(ctx, erc20.getClient())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = erc20.getClient().SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return tx.Hash(), nil
+}
+
+func (erc20 *ERC20) getClient() types.IClient {
+	if erc20.networkType == types.Root {
+		return erc20.client.pos.Root
+	} else {
+		return erc20.client.pos.Child
+	}
+}
+
+func (erc20 *ERC20) checkForRoot(method string) error {
+	if erc20.networkType != types.Root {
+		return fmt.Errorf("allowed on root %s", method)
+	}
+	return nil
+}
+
+func (erc20 *ERC20) checkForChild(method string) error {
+	if erc20.networkType != types.Child {
+		return fmt.Errorf("allowed on child %s", method)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package plasma
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/pos"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+var (
+	TestPrivateKey, _ = crypto.HexToECDSA("1c28edecd1cdfbdb2e32c38d8e06ed042f3e31fb05d9884e5322376cce4706d4")
+	TestTxOption      = &types.TxOption{
+		PrivateKey: TestPrivateKey,
+		TxType:     types.DynamicFeeTxType,
+	}
+)
+
+func newTestClient(t *testing.T) *Client {
+	posClient, err := pos.NewClient(pos.NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	config, err := NewDefaultConfig(types.TestNet)
+	require.NoError(t, err)
+
+	client, err := NewClient(posClient, config)
+	require.NoError(t, err)
+	return client
+}
+
+func TestERC20_Deposit(t *testing.T) {
+	client := newTestClient(t)
+
+	hash, err := client.Matic(types.Root).Deposit(context.Background(), big.NewInt(123456789), TestTxOption)
+	assert.NoError(t, err)
+	t.Log("txHash", hash.String())
+}
+
+func TestERC20_Withdraw(t *testing.T) {
+	client := newTestClient(t)
+
+	hash, err := client.Matic(types.Child).Withdraw(context.Background(), big.NewInt(123456789), TestTxOption)
+	assert.NoError(t, err)
+	t.Log("txHash", hash.String())
+}
+
+func TestERC20_StartExitOnChild(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.Matic(types.Child).StartExit(context.Background(), common.Hash{}, TestTxOption)
+	assert.Error(t, err)
+}
+
+func TestERC20_Balance(t *testing.T) {
+	client := newTestClient(t)
+
+	balance, err := client.Matic(types.Child).BalanceOf(context.Background(), TestTxOption.From())
+	assert.NoError(t, err)
+	t.Log(balance)
+}
@@ -20,6 +20,7 @@ type BaseToken struct {
 	logger           *types.Logger
 	address          common.Address
 	predicateAddress common.Address
+	mappedType       types.TokenType
 }
 
 func newBaseToken(client *Client, address common.Address, networkType types.NetworkType, tokenType types.TokenType) *BaseToken {
@@ -28,6 +29,7 @@ func newBaseToken(client *Client, address common.Address, networkType types.Netw
 		config:      client.config,
 		address:     address,
 		networkType: networkType,
+		tokenType:   tokenType,
 		logger:      types.NewLogger(tokenType.String(), client.config.Debug),
 	}
 }
@@ -86,6 +88,21 @@ func (token *BaseToken) exit(ctx context.Context, payload []byte, txOption *type
 	token.logger.Debug("exit", log.Fields{
 		"payload": hexutil.Encode(payload),
 	})
+
+	exitPayload, err := types.DecodeExitPayload(payload)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	processed, err := token.client.IsExitProcessed(ctx, exitPayload.ExitHash())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if processed {
+		return common.Hash{}, fmt.Errorf("%w: block %d log %d", types.ExitAlreadyProcessed, exitPayload.BlockNumber, exitPayload.LogIndex)
+	}
+
 	data, err := maticabi.RootChainManager.Pack("exit", payload)
 	if err != nil {
 		return common.Hash{}, err
@@ -95,7 +112,7 @@ func (token *BaseToken) exit(ctx context.Context, payload []byte, txOption *type
 	if err != nil {
 		return common.Hash{}, err
 	}
-	
+
 	err = token.getClient().SendTransaction(ctx, tx)
 	if err != nil {
 		return common.Hash{}, err
@@ -120,10 +137,7 @@ func (token *BaseToken) PredicateAddress() common.Address {
 		return token.predicateAddress
 	}
 
-	tokenTypeResp, err := utils.CallContract(context.Background(), token.client.Root, token.config.Root.RootChainManager, maticabi.RootChainManager,
-		"tokenToType",
-		token.address,
-	)
+	tokenTypeHash, err := token.tokenToType(context.Background())
 	if err != nil {
 		token.Logger().Error("tokenToType", log.Fields{
 			"error": err.Error(),
@@ -133,7 +147,7 @@ func (token *BaseToken) PredicateAddress() common.Address {
 
 	typeToPredicateResp, err := utils.CallContract(context.Background(), token.client.Root, token.config.Root.RootChainManager, maticabi.RootChainManager,
 		"typeToPredicate",
-		tokenTypeResp[0],
+		tokenTypeHash,
 	)
 	if err != nil {
 		token.Logger().Error("typeToPredicate", log.Fields{
@@ -149,6 +163,64 @@ func (token *BaseToken) PredicateAddress() common.Address {
 	return token.predicateAddress
 }
 
+// TokenType : token type registered on RootChainManager, resolves mintable tokens
+func (token *BaseToken) TokenType(ctx context.Context) (types.TokenType, error) {
+	if err := token.checkForRoot("TokenType"); err != nil {
+		return 0, err
+	}
+
+	if token.mappedType != 0 {
+		return token.mappedType, nil
+	}
+
+	tokenTypeHash, err := token.tokenToType(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tokenType, ok := types.TokenTypeFromHash(tokenTypeHash)
+	if !ok {
+		return 0, fmt.Errorf("unknown token type %s: %s", common.Hash(tokenTypeHash), token.address)
+	}
+
+	if tokenType.Standard() != token.tokenType.Standard() {
+		return 0, fmt.Errorf("token %s is %s, not %s", token.address, tokenType.Name(), token.tokenType.Name())
+	}
+	token.mappedType = tokenType
+
+	token.Logger().Debug("TokenType", log.Fields{
+		"tokenType": tokenType.Name(),
+	})
+	return token.mappedType, nil
+}
+
+// IsMintable : whether the token is mapped to a mintable predicate
+func (token *BaseToken) IsMintable(ctx context.Context) (bool, error) {
+	tokenType, err := token.TokenType(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return tokenType.IsMintable(), nil
+}
+
+func (token *BaseToken) tokenToType(ctx context.Context) ([32]byte, error) {
+	tokenToTypeResp, err := utils.CallContract(ctx, token.client.Root, token.config.Root.RootChainManager, maticabi.RootChainManager,
+		"tokenToType",
+		token.address,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	tokenTypeHash := tokenToTypeResp[0].([32]byte)
+	if tokenTypeHash == ([32]byte{}) {
+		return [32]byte{}, fmt.Errorf("token not mapped: %s", token.address)
+	}
+
+	return tokenTypeHash, nil
+}
+
 func (token *BaseToken) getClient() types.IClient {
 	if token.networkType == types.Root {
 		return token.client.Root
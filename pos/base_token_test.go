@@ -1,15 +1,17 @@
 package pos
 
 import (
+	"context"
 	"github.com/MinseokOh/matic-sdk-go/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
 func TestBaseToken_getPredicateAddress(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20Predicate := client.ERC20(RootDummyERC20, types.Root).PredicateAddress()
 	assert.Equal(t, erc20Predicate, common.HexToAddress("0xdD6596F2029e6233DEFfaCa316e6A95217d4Dc34"))
@@ -17,3 +19,15 @@ func TestBaseToken_getPredicateAddress(t *testing.T) {
 	erc721Predicate := client.ERC721(RootDummyERC721, types.Root).PredicateAddress()
 	assert.Equal(t, erc721Predicate, common.HexToAddress("0x56E14C4C1748a818a5564D33cF774c59EB3eDF59"))
 }
+
+func TestBaseToken_TokenType(t *testing.T) {
+	client, err := NewClient(NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	tokenType, err := client.ERC20(RootDummyERC20, types.Root).TokenType(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, types.ERC20, tokenType)
+
+	_, err = client.ERC721(RootDummyERC20, types.Root).TokenType(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,142 @@
+package pos
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newBlockProofTestHeaders : child headers of blocks [start, end]
+func newBlockProofTestHeaders(start, end uint64) map[uint64]*ether.Header {
+	headers := make(map[uint64]*ether.Header)
+	for number := start; number <= end; number++ {
+		headers[number] = &ether.Header{
+			Number:      new(big.Int).SetUint64(number),
+			Time:        1670000000 + 2*number,
+			TxHash:      crypto.Keccak256Hash([]byte("tx"), big.NewInt(int64(number)).Bytes()),
+			ReceiptHash: crypto.Keccak256Hash([]byte("receipt"), big.NewInt(int64(number)).Bytes()),
+			Difficulty:  big.NewInt(1),
+		}
+	}
+	return headers
+}
+
+func blockProofTestRoot(t *testing.T, headers map[uint64]*ether.Header, start, end uint64) common.Hash {
+	leaves := make([]common.Hash, 0, end-start+1)
+	for number := start; number <= end; number++ {
+		header := headers[number]
+		leaves = append(leaves, utils.BlockProofLeaf(number, header.Time, header.TxHash, header.ReceiptHash))
+	}
+	merkleTree, err := utils.NewMerkleTree(leaves)
+	assert.NoError(t, err)
+	return merkleTree.GetRoot()
+}
+
+func headerHandler(headers map[uint64]*ether.Header, calls *int32) rpcHandler {
+	return func(params []json.RawMessage) (interface{}, error) {
+		atomic.AddInt32(calls, 1)
+
+		var number hexutil.Uint64
+		if err := json.Unmarshal(params[0], &number); err != nil {
+			return nil, err
+		}
+		return headers[uint64(number)], nil
+	}
+}
+
+// rootHashHandler : bor eth_getRootHash on top of headers, hex without 0x prefix
+func rootHashHandler(t *testing.T, headers map[uint64]*ether.Header, calls *int32) rpcHandler {
+	return func(params []json.RawMessage) (interface{}, error) {
+		atomic.AddInt32(calls, 1)
+
+		var start, end uint64
+		assert.NoError(t, json.Unmarshal(params[0], &start))
+		assert.NoError(t, json.Unmarshal(params[1], &end))
+		return strings.TrimPrefix(blockProofTestRoot(t, headers, start, end).Hex(), "0x"), nil
+	}
+}
+
+func TestBuildBlockProof_RootHashSource(t *testing.T) {
+	const start, end = 100, 115
+	headers := newBlockProofTestHeaders(start, end)
+	checkpointRoot := blockProofTestRoot(t, headers, start, end)
+
+	tests := []struct {
+		name        string
+		source      types.RootHashSource
+		rootHashRPC bool
+		rootHashes  bool
+		headers     bool
+	}{
+		{"rpc", types.RootHashRPC, true, true, false},
+		{"auto with rpc", types.RootHashAuto, true, true, false},
+		{"auto without rpc", types.RootHashAuto, false, false, true},
+		{"local", types.RootHashLocal, true, false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var rootHashCalls, headerCalls int32
+			handlers := map[string]rpcHandler{
+				"eth_getBlockByNumber": headerHandler(headers, &headerCalls),
+			}
+			if test.rootHashRPC {
+				handlers["eth_getRootHash"] = rootHashHandler(t, headers, &rootHashCalls)
+			}
+			client := newTestClient(t, newTestRPC(t, nil), newTestRPC(t, handlers))
+
+			for _, number := range []uint64{start, 107, 108, end} {
+				proof, err := utils.BuildBlockProof(context.Background(), client.Child, test.source,
+					new(big.Int).SetUint64(number), big.NewInt(start), big.NewInt(end))
+				assert.NoError(t, err)
+
+				header := headers[number]
+				leaf := utils.BlockProofLeaf(number, header.Time, header.TxHash, header.ReceiptHash)
+				assert.NoError(t, utils.VerifyBlockProof(leaf, number-start, checkpointRoot, proof))
+			}
+
+			assert.Equal(t, test.rootHashes, atomic.LoadInt32(&rootHashCalls) > 0)
+			assert.Equal(t, test.headers, atomic.LoadInt32(&headerCalls) > 0)
+		})
+	}
+}
+
+func TestBuildBlockProof_RootHashErrors(t *testing.T) {
+	const start, end = 100, 115
+
+	// rpc only, node without eth_getRootHash
+	client := newTestClient(t, newTestRPC(t, nil), newTestRPC(t, nil))
+	_, err := utils.BuildBlockProof(context.Background(), client.Child, types.RootHashRPC,
+		big.NewInt(107), big.NewInt(start), big.NewInt(end))
+	assert.ErrorContains(t, err, "eth_getRootHash")
+
+	// null result is an error, not a panic
+	client = newTestClient(t, newTestRPC(t, nil), newTestRPC(t, map[string]rpcHandler{
+		"eth_getRootHash": func(params []json.RawMessage) (interface{}, error) {
+			return nil, nil
+		},
+	}))
+	_, err = utils.BuildBlockProof(context.Background(), client.Child, types.RootHashRPC,
+		big.NewInt(107), big.NewInt(start), big.NewInt(end))
+	assert.ErrorContains(t, err, "not found")
+
+	// local, header beyond the head of the node
+	headers := newBlockProofTestHeaders(start, end-1)
+	var headerCalls int32
+	client = newTestClient(t, newTestRPC(t, nil), newTestRPC(t, map[string]rpcHandler{
+		"eth_getBlockByNumber": headerHandler(headers, &headerCalls),
+	}))
+	_, err = utils.BuildBlockProof(context.Background(), client.Child, types.RootHashLocal,
+		big.NewInt(107), big.NewInt(start), big.NewInt(end))
+	assert.ErrorContains(t, err, "header of block 115")
+}
@@ -0,0 +1,340 @@
+package pos
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// checkpointLogsWindow : root blocks per FilterLogs request, within the range limit of public rpcs
+const checkpointLogsWindow = 1000
+
+// CheckpointWatcher : follows NewHeaderBlock events of RootChain and notifies registered burn txs
+// once their child block is checkpointed
+type CheckpointWatcher struct {
+	root   *RootClient
+	child  types.IClient
+	logger *types.Logger
+
+	mu             sync.Mutex
+	lastChildBlock *big.Int
+	lastRootBlock  uint64
+	watches        map[common.Hash]*checkpointWatch
+	cancel         context.CancelFunc
+	done           chan struct{}
+}
+
+// checkpointWatch : channels of every Watch call of a burn tx
+type checkpointWatch struct {
+	blockNumber *big.Int
+	chs         []chan types.RootBlockInfo
+}
+
+// NewCheckpointWatcher : watcher of checkpoints on root, child resolves block numbers of burn txs
+func (root *RootClient) NewCheckpointWatcher(child types.IClient) *CheckpointWatcher {
+	return &CheckpointWatcher{
+		root:    root,
+		child:   child,
+		logger:  root.logger,
+		watches: make(map[common.Hash]*checkpointWatch),
+	}
+}
+
+func (watcher *CheckpointWatcher) Logger() *types.Logger { return watcher.logger }
+
+// Start : sync the last checkpointed child block and follow new checkpoints until Stop or ctx is done,
+// subscribes to logs when the rpc supports it and polls otherwise. Starting again requires Stop.
+func (watcher *CheckpointWatcher) Start(ctx context.Context) error {
+	watcher.mu.Lock()
+	if watcher.cancel != nil {
+		watcher.mu.Unlock()
+		return fmt.Errorf("checkpoint watcher already started")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	watcher.cancel = cancel
+	watcher.done = done
+	watcher.mu.Unlock()
+
+	lastChildBlock, err := watcher.root.GetLastChildBlock(ctx)
+	if err != nil {
+		watcher.abortStart(cancel, done)
+		return err
+	}
+
+	lastRootBlock, err := watcher.root.BlockNumber(ctx)
+	if err != nil {
+		watcher.abortStart(cancel, done)
+		return err
+	}
+
+	watcher.mu.Lock()
+	watcher.lastChildBlock = lastChildBlock
+	watcher.lastRootBlock = lastRootBlock
+	watcher.mu.Unlock()
+
+	go func() {
+		defer close(done)
+		if err := watcher.subscribe(ctx); err != nil {
+			watcher.Logger().Debug("CheckpointWatcher", log.Fields{
+				"subscribe": err.Error(),
+			})
+			watcher.poll(ctx)
+		}
+	}()
+
+	return nil
+}
+
+// abortStart : release a Start which failed to sync
+func (watcher *CheckpointWatcher) abortStart(cancel context.CancelFunc, done chan struct{}) {
+	cancel()
+	watcher.mu.Lock()
+	if watcher.done == done {
+		watcher.cancel, watcher.done = nil, nil
+	}
+	watcher.mu.Unlock()
+	close(done)
+}
+
+// Stop : stop following checkpoints, channels of pending watches are closed without notification
+func (watcher *CheckpointWatcher) Stop() {
+	watcher.mu.Lock()
+	cancel, done := watcher.cancel, watcher.done
+	watcher.cancel, watcher.done = nil, nil
+	watcher.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	for burnTxHash, watch := range watcher.watches {
+		for _, ch := range watch.chs {
+			close(ch)
+		}
+		delete(watcher.watches, burnTxHash)
+	}
+}
+
+// LastChildBlock : last child block included in a checkpoint
+func (watcher *CheckpointWatcher) LastChildBlock() *big.Int {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	if watcher.lastChildBlock == nil {
+		return nil
+	}
+	return new(big.Int).Set(watcher.lastChildBlock)
+}
+
+// Watch : channel receiving the checkpoint that makes burn tx exit-eligible, closed after the notification or by Stop.
+// Burn txs which are already checkpointed are notified right away, every Watch of the same burn tx is notified.
+func (watcher *CheckpointWatcher) Watch(ctx context.Context, burnTxHash common.Hash) (<-chan types.RootBlockInfo, error) {
+	ch := make(chan types.RootBlockInfo, 1)
+	watcher.mu.Lock()
+	if watch, ok := watcher.watches[burnTxHash]; ok {
+		watch.chs = append(watch.chs, ch)
+		watcher.mu.Unlock()
+		return ch, nil
+	}
+	watcher.mu.Unlock()
+
+	receipt, err := watcher.child.TransactionReceipt(ctx, burnTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher.mu.Lock()
+	if watcher.cancel == nil || watcher.lastChildBlock == nil {
+		watcher.mu.Unlock()
+		return nil, fmt.Errorf("checkpoint watcher not started")
+	}
+
+	if watcher.lastChildBlock.Cmp(receipt.BlockNumber) >= 0 {
+		watcher.mu.Unlock()
+
+		blockInfo, err := watcher.root.GetRootBlockInfo(ctx, receipt.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		ch <- blockInfo
+		close(ch)
+		return ch, nil
+	}
+
+	if watch, ok := watcher.watches[burnTxHash]; ok {
+		// watched meanwhile
+		watch.chs = append(watch.chs, ch)
+	} else {
+		watcher.watches[burnTxHash] = &checkpointWatch{
+			blockNumber: receipt.BlockNumber,
+			chs:         []chan types.RootBlockInfo{ch},
+		}
+	}
+	watcher.mu.Unlock()
+
+	watcher.Logger().Debug("Watch", log.Fields{
+		"burnTxHash":     burnTxHash,
+		"blockNumber":    receipt.BlockNumber,
+		"lastChildBlock": watcher.LastChildBlock(),
+	})
+	return ch, nil
+}
+
+// Unwatch : drop the watch of burn tx, its channels are closed without notification
+func (watcher *CheckpointWatcher) Unwatch(burnTxHash common.Hash) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	if watch, ok := watcher.watches[burnTxHash]; ok {
+		for _, ch := range watch.chs {
+			close(ch)
+		}
+		delete(watcher.watches, burnTxHash)
+	}
+}
+
+func (watcher *CheckpointWatcher) filterQuery() ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{watcher.root.config.RootChain},
+		Topics:    [][]common.Hash{{common.HexToHash(types.NewHeaderBlock)}},
+	}
+}
+
+func (watcher *CheckpointWatcher) subscribe(ctx context.Context) error {
+	logs := make(chan ether.Log)
+	subscription, err := watcher.root.SubscribeFilterLogs(ctx, watcher.filterQuery(), logs)
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+
+	// checkpoints between Start and the subscription
+	if err := watcher.pollLogs(ctx); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-subscription.Err():
+			return err
+		case headerBlockLog := <-logs:
+			watcher.handleLog(headerBlockLog)
+		}
+	}
+}
+
+func (watcher *CheckpointWatcher) poll(ctx context.Context) {
+	ticker := time.NewTicker(watcher.root.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := watcher.pollLogs(ctx); err != nil {
+			watcher.Logger().Error("CheckpointWatcher", log.Fields{
+				"error": err.Error(),
+			})
+		}
+	}
+}
+
+// pollLogs : logs from the last seen root block up to latest, in windows of checkpointLogsWindow blocks.
+// lastRootBlock advances per window so a failed request resumes from the window that failed.
+func (watcher *CheckpointWatcher) pollLogs(ctx context.Context) error {
+	latest, err := watcher.root.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	watcher.mu.Lock()
+	from := watcher.lastRootBlock + 1
+	watcher.mu.Unlock()
+
+	for from <= latest {
+		to := from + checkpointLogsWindow - 1
+		if to > latest {
+			to = latest
+		}
+
+		query := watcher.filterQuery()
+		query.FromBlock = new(big.Int).SetUint64(from)
+		query.ToBlock = new(big.Int).SetUint64(to)
+		logs, err := watcher.root.FilterLogs(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, headerBlockLog := range logs {
+			watcher.handleLog(headerBlockLog)
+		}
+
+		watcher.mu.Lock()
+		if to > watcher.lastRootBlock {
+			watcher.lastRootBlock = to
+		}
+		watcher.mu.Unlock()
+		from = to + 1
+	}
+	return nil
+}
+
+// handleLog : NewHeaderBlock(address indexed proposer, uint256 indexed headerBlockId, uint256 indexed reward, uint256 start, uint256 end, bytes32 root)
+func (watcher *CheckpointWatcher) handleLog(headerBlockLog ether.Log) {
+	if headerBlockLog.Removed || len(headerBlockLog.Topics) < 3 || len(headerBlockLog.Data) < 96 {
+		return
+	}
+
+	blockInfo := types.RootBlockInfo{
+		HeaderBlockNumber: headerBlockLog.Topics[2].Big(),
+		Start:             new(big.Int).SetBytes(headerBlockLog.Data[:32]),
+		End:               new(big.Int).SetBytes(headerBlockLog.Data[32:64]),
+		Root:              common.BytesToHash(headerBlockLog.Data[64:96]),
+		Proposer:          common.BytesToAddress(headerBlockLog.Topics[1].Bytes()),
+	}
+
+	watcher.Logger().Debug("NewHeaderBlock", log.Fields{
+		"headerBlock": blockInfo.HeaderBlockNumber,
+		"start":       blockInfo.Start,
+		"end":         blockInfo.End,
+	})
+
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	if watcher.lastChildBlock.Cmp(blockInfo.End) < 0 {
+		watcher.lastChildBlock = blockInfo.End
+	}
+
+	if headerBlockLog.BlockNumber > watcher.lastRootBlock {
+		watcher.lastRootBlock = headerBlockLog.BlockNumber
+	}
+
+	for burnTxHash, watch := range watcher.watches {
+		if watch.blockNumber.Cmp(blockInfo.Start) >= 0 && watch.blockNumber.Cmp(blockInfo.End) <= 0 {
+			for _, ch := range watch.chs {
+				ch <- blockInfo
+				close(ch)
+			}
+			delete(watcher.watches, burnTxHash)
+		}
+	}
+}
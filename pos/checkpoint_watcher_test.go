@@ -0,0 +1,164 @@
+package pos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCheckpointWatcher_Watch(t *testing.T) {
+	burnTxHash := common.HexToHash("0x01")
+	var rootBlock int64 = 1000
+	var watching, checkpointed int32
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_call": func(params []json.RawMessage) (interface{}, error) {
+			// getLastChildBlock
+			return hexutil.Bytes(common.BigToHash(big.NewInt(500)).Bytes()), nil
+		},
+		"eth_blockNumber": func(params []json.RawMessage) (interface{}, error) {
+			return hexutil.Uint64(atomic.AddInt64(&rootBlock, 1)), nil
+		},
+		"eth_getLogs": func(params []json.RawMessage) (interface{}, error) {
+			// checkpoint once the burn tx is watched
+			if atomic.LoadInt32(&watching) == 0 || !atomic.CompareAndSwapInt32(&checkpointed, 0, 1) {
+				return []*ether.Log{}, nil
+			}
+
+			return []*ether.Log{{
+				Topics: []common.Hash{
+					common.HexToHash(types.NewHeaderBlock),
+					common.HexToHash("0x02"),
+					common.BigToHash(big.NewInt(20000)),
+					common.BigToHash(big.NewInt(1)),
+				},
+				Data: append(append(
+					common.BigToHash(big.NewInt(501)).Bytes(),
+					common.BigToHash(big.NewInt(756)).Bytes()...),
+					common.HexToHash("0x03").Bytes()...),
+				BlockNumber: 1002,
+				TxHash:      common.HexToHash("0x04"),
+				BlockHash:   common.HexToHash("0x05"),
+			}}, nil
+		},
+	})
+	child := newTestRPC(t, map[string]rpcHandler{
+		"eth_getTransactionReceipt": func(params []json.RawMessage) (interface{}, error) {
+			var txHash common.Hash
+			assert.NoError(t, json.Unmarshal(params[0], &txHash))
+
+			// burn txs other than burnTxHash are never checkpointed
+			blockNumber := big.NewInt(600)
+			if txHash != burnTxHash {
+				blockNumber = big.NewInt(100000)
+			}
+			return &ether.Receipt{
+				Status:      ether.ReceiptStatusSuccessful,
+				Logs:        []*ether.Log{},
+				TxHash:      txHash,
+				BlockHash:   common.HexToHash("0x06"),
+				BlockNumber: blockNumber,
+			}, nil
+		},
+	})
+	client := newTestClient(t, root, child)
+	client.Root.WithPollInterval(time.Millisecond)
+
+	watcher := client.CheckpointWatcher()
+	_, err := watcher.Watch(context.Background(), burnTxHash)
+	assert.Error(t, err)
+
+	assert.NoError(t, watcher.Start(context.Background()))
+	defer watcher.Stop()
+	assert.Equal(t, big.NewInt(500), watcher.LastChildBlock())
+	assert.ErrorContains(t, watcher.Start(context.Background()), "already started")
+
+	// every watch of the burn tx is notified
+	ch, err := watcher.Watch(context.Background(), burnTxHash)
+	assert.NoError(t, err)
+	again, err := watcher.Watch(context.Background(), burnTxHash)
+	assert.NoError(t, err)
+	atomic.StoreInt32(&watching, 1)
+
+	for _, ch := range []<-chan types.RootBlockInfo{ch, again} {
+		select {
+		case blockInfo, ok := <-ch:
+			assert.True(t, ok)
+			assert.Equal(t, big.NewInt(20000), blockInfo.HeaderBlockNumber)
+			assert.Equal(t, big.NewInt(501), blockInfo.Start)
+			assert.Equal(t, big.NewInt(756), blockInfo.End)
+			assert.Equal(t, common.HexToHash("0x03"), blockInfo.Root)
+			assert.Equal(t, common.HexToAddress("0x02"), blockInfo.Proposer)
+		case <-time.After(5 * time.Second):
+			t.Fatal("checkpoint not notified")
+		}
+	}
+
+	assert.Equal(t, big.NewInt(756), watcher.LastChildBlock())
+
+	// pending watches are closed by Stop
+	pending, err := watcher.Watch(context.Background(), common.HexToHash("0x07"))
+	assert.NoError(t, err)
+	watcher.Stop()
+	select {
+	case _, ok := <-pending:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("pending watch not closed")
+	}
+	_, err = watcher.Watch(context.Background(), common.HexToHash("0x07"))
+	assert.ErrorContains(t, err, "not started")
+
+	// restart after Stop
+	assert.NoError(t, watcher.Start(context.Background()))
+}
+
+func TestCheckpointWatcher_PollLogs(t *testing.T) {
+	var requests [][2]uint64
+	failAt := -1
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_blockNumber": func(params []json.RawMessage) (interface{}, error) {
+			return hexutil.Uint64(1000 + 2*checkpointLogsWindow + 500), nil
+		},
+		"eth_getLogs": func(params []json.RawMessage) (interface{}, error) {
+			var query struct {
+				FromBlock hexutil.Uint64 `json:"fromBlock"`
+				ToBlock   hexutil.Uint64 `json:"toBlock"`
+			}
+			assert.NoError(t, json.Unmarshal(params[0], &query))
+
+			if len(requests) == failAt {
+				return nil, fmt.Errorf("query returned more than 10000 results")
+			}
+			requests = append(requests, [2]uint64{uint64(query.FromBlock), uint64(query.ToBlock)})
+			return []*ether.Log{}, nil
+		},
+	})
+	client := newTestClient(t, root, newTestRPC(t, nil))
+
+	watcher := client.Root.NewCheckpointWatcher(client.Child)
+	watcher.lastChildBlock = big.NewInt(500)
+	watcher.lastRootBlock = 1000
+
+	// a failed window keeps the windows before it
+	failAt = 1
+	assert.ErrorContains(t, watcher.pollLogs(context.Background()), "more than")
+	assert.Equal(t, uint64(1000+checkpointLogsWindow), watcher.lastRootBlock)
+
+	failAt = -1
+	assert.NoError(t, watcher.pollLogs(context.Background()))
+	assert.Equal(t, [][2]uint64{
+		{1001, 1000 + checkpointLogsWindow},
+		{1001 + checkpointLogsWindow, 1000 + 2*checkpointLogsWindow},
+		{1001 + 2*checkpointLogsWindow, 1000 + 2*checkpointLogsWindow + 500},
+	}, requests)
+	assert.Equal(t, uint64(1000+2*checkpointLogsWindow+500), watcher.lastRootBlock)
+}
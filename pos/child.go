@@ -1,10 +1,15 @@
 package pos
 
 import (
+	"context"
 	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	log "github.com/sirupsen/logrus"
+	"time"
 )
 
 type ChildClient struct {
@@ -12,26 +17,68 @@ type ChildClient struct {
 	rpc    *rpc.Client
 	config types.ChildConfig
 	logger *types.Logger
+
+	endpoints    *types.EndpointPool
+	nonceManager *types.NonceManager
+	pollInterval time.Duration
 }
 
 func NewChildClient(config types.POSClientConfig) (*ChildClient, error) {
 	child := ChildClient{
 		config: config.Child,
 		logger: types.NewLogger("child", config.Debug),
+
+		nonceManager: types.DefaultNonceManager,
+		pollInterval: types.DefaultPollInterval,
 	}
 	var err error
-	child.rpc, err = rpc.Dial(child.config.Rpc)
+	child.rpc, child.endpoints, err = dialEndpoints(child.config.Endpoints(), child.logger)
 	if err != nil {
 		return nil, err
 	}
 	child.Client = ethclient.NewClient(child.rpc)
 
 	child.Logger().Debug("NewChildClient", log.Fields{
-		"rpc" : child.config.Rpc,
+		"rpc":       child.config.Rpc,
+		"fallbacks": child.config.Fallbacks,
 	})
 
 	return &child, nil
 }
 
-func (child *ChildClient) Rpc() *rpc.Client      { return child.rpc }
-func (child *ChildClient) Logger() *types.Logger { return child.logger }
+func (child *ChildClient) Rpc() *rpc.Client                  { return child.rpc }
+func (child *ChildClient) Logger() *types.Logger             { return child.logger }
+func (child *ChildClient) NonceManager() *types.NonceManager { return child.nonceManager }
+
+// Endpoints : failover pool of Rpc and Fallbacks, nil without fallbacks
+func (child *ChildClient) Endpoints() *types.EndpointPool { return child.endpoints }
+
+// WithNonceManager : replace the process wide nonce manager
+func (child *ChildClient) WithNonceManager(nonceManager *types.NonceManager) *ChildClient {
+	child.nonceManager = nonceManager
+	return child
+}
+
+// WithPollInterval : receipt polling interval of WaitMined and WaitConfirmed
+func (child *ChildClient) WithPollInterval(pollInterval time.Duration) *ChildClient {
+	child.pollInterval = pollInterval
+	return child
+}
+
+// SendTransaction : send tx and report the result to the nonce manager
+func (child *ChildClient) SendTransaction(ctx context.Context, tx *ether.Transaction) error {
+	err := child.Client.SendTransaction(ctx, tx)
+	child.nonceManager.Sent(tx, err)
+	return err
+}
+
+// WaitMined : receipt of tx once mined, *types.TxFailedError when the receipt status is failed
+func (child *ChildClient) WaitMined(ctx context.Context, txHash common.Hash) (*ether.Receipt, error) {
+	return utils.WaitMined(ctx, child, txHash, child.pollInterval)
+}
+
+// WaitConfirmed : receipt of tx once buried under confirmations blocks,
+// types.TxDropped or types.TxReplaced when the tx leaves the pool without being mined
+func (child *ChildClient) WaitConfirmed(ctx context.Context, txHash common.Hash, confirmations uint64) (*ether.Receipt, error) {
+	return utils.WaitConfirmed(ctx, child, txHash, confirmations, child.pollInterval)
+}
@@ -1,6 +1,7 @@
 package pos
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/MinseokOh/matic-sdk-go/types"
@@ -8,9 +9,12 @@ import (
 	"github.com/MinseokOh/matic-sdk-go/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
 	log "github.com/sirupsen/logrus"
 	"math/big"
+	"time"
 )
 
 type Client struct {
@@ -20,19 +24,52 @@ type Client struct {
 	Root   *RootClient
 }
 
+// NewDefaultConfig : config of network with the public rpc endpoints of its registry,
+// empty for unsupported networks so that NewClient rejects it
 func NewDefaultConfig(network types.Network) types.POSClientConfig {
-	contract := utils.GetContractByNetwork(network)
+	config, _ := defaultConfig(network)
+	return config
+}
+
+// defaultConfig : NewDefaultConfig, an error for unsupported networks
+func defaultConfig(network types.Network) (types.POSClientConfig, error) {
+	contract, err := utils.GetContractByNetwork(network)
+	if err != nil {
+		return types.POSClientConfig{}, err
+	}
 	return types.POSClientConfig{
-		Child: contract.ChildConfig("https://rpc.ankr.com/polygon_mumbai"),
-		Root:  contract.RootConfig("https://rpc.ankr.com/eth_goerli"),
+		Child: contract.ChildConfig(contract.Matic.RPC),
+		Root:  contract.RootConfig(contract.Main.RPC),
 		Debug: types.DebugConfig{
 			Enable: true,
 			Level:  types.DebugLevel,
 		},
+	}, nil
+}
+
+// dialEndpoints : rpc client of the first endpoint, or of a failover pool over all of them
+func dialEndpoints(endpoints []string, logger *types.Logger) (*rpc.Client, *types.EndpointPool, error) {
+	if len(endpoints) == 1 {
+		client, err := rpc.Dial(endpoints[0])
+		return client, nil, err
 	}
+
+	pool, err := types.NewEndpointPool(endpoints, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := pool.Dial()
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, pool, nil
 }
 
 func NewClient(config types.POSClientConfig) (*Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	client := Client{
 		config: config,
 		logger: types.NewLogger("pos", config.Debug),
@@ -52,7 +89,13 @@ func NewClient(config types.POSClientConfig) (*Client, error) {
 	return &client, nil
 }
 
-func (client *Client) Logger() *types.Logger { return client.logger }
+func (client *Client) Logger() *types.Logger         { return client.logger }
+func (client *Client) Config() types.POSClientConfig { return client.config }
+
+// CheckpointWatcher : checkpoint watcher of root resolving burn txs on child, must be started before Watch
+func (client *Client) CheckpointWatcher() *CheckpointWatcher {
+	return client.Root.NewCheckpointWatcher(client.Child)
+}
 
 func (client *Client) ERC20(address common.Address, networkType types.NetworkType) *ERC20 {
 	return newERC20(client, address, networkType)
@@ -62,6 +105,46 @@ func (client *Client) ERC721(address common.Address, networkType types.NetworkTy
 	return newERC721(client, address, networkType)
 }
 
+func (client *Client) ERC1155(address common.Address, networkType types.NetworkType) *ERC1155 {
+	return newERC1155(client, address, networkType)
+}
+
+// RootToken : root token mapped to the child token, tokens minted on child are mapped before their first exit
+func (client *Client) RootToken(ctx context.Context, childToken common.Address) (common.Address, error) {
+	childToRootTokenResp, err := utils.CallContract(ctx, client.Root, client.config.Root.RootChainManager, maticabi.RootChainManager,
+		"childToRootToken",
+		childToken,
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	rootToken := childToRootTokenResp[0].(common.Address)
+	if rootToken == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("child token not mapped: %s", childToken)
+	}
+
+	return rootToken, nil
+}
+
+// ChildToken : child token mapped to the root token
+func (client *Client) ChildToken(ctx context.Context, rootToken common.Address) (common.Address, error) {
+	rootToChildTokenResp, err := utils.CallContract(ctx, client.Root, client.config.Root.RootChainManager, maticabi.RootChainManager,
+		"rootToChildToken",
+		rootToken,
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	childToken := rootToChildTokenResp[0].(common.Address)
+	if childToken == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("root token not mapped: %s", rootToken)
+	}
+
+	return childToken, nil
+}
+
 func (client *Client) DepositEtherFor(ctx context.Context, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
 	client.Logger().Debug("DepositEtherFor", log.Fields{
 		"amount": amount,
@@ -100,7 +183,48 @@ func (client *Client) ExitEther(ctx context.Context, txHash common.Hash, txOptio
 	return client.ERC20(common.Address{}, types.Root).Exit(ctx, txHash, txOption)
 }
 
+// IsExited : exit of the log at logIndex of burn tx receipt is processed by RootChainManager
+func (client *Client) IsExited(ctx context.Context, burnTxHash common.Hash, logIndex uint64) (bool, error) {
+	client.Logger().Debug("IsExited", log.Fields{
+		"burnTxHash": burnTxHash,
+		"logIndex":   logIndex,
+	})
+
+	receipt, err := client.Child.TransactionReceipt(ctx, burnTxHash)
+	if err != nil {
+		return false, err
+	}
+
+	path, err := rlp.EncodeToBytes(receipt.TransactionIndex)
+	if err != nil {
+		return false, err
+	}
+
+	exitHash := types.ExitHash(receipt.BlockNumber.Uint64(), append([]byte{0}, path...), logIndex)
+	return client.IsExitProcessed(ctx, exitHash)
+}
+
+// IsExitProcessed : processedExits of RootChainManager
+func (client *Client) IsExitProcessed(ctx context.Context, exitHash common.Hash) (bool, error) {
+	processedExitsResp, err := utils.CallContract(ctx, client.Root, client.config.Root.RootChainManager, maticabi.RootChainManager,
+		"processedExits",
+		exitHash,
+	)
+	if err != nil {
+		return false, err
+	}
+	processed := processedExitsResp[0].(bool)
+
+	client.Logger().Debug("IsExitProcessed", log.Fields{
+		"exitHash":  exitHash,
+		"processed": processed,
+	})
+	return processed, nil
+}
+
 func (client *Client) BuildPayloadForExit(ctx context.Context, txHash common.Hash, eventSignature string, index int) ([]byte, error) {
+	// receipt, block and proofs of one child endpoint
+	ctx = types.StickyContext(ctx)
 	client.Logger().Debug("BuildPayloadForExit", log.Fields{
 		"txHash": txHash,
 	})
@@ -128,7 +252,7 @@ func (client *Client) BuildPayloadForExit(ctx context.Context, txHash common.Has
 	}
 
 	client.Logger().Debug("BuildBlockProof", nil)
-	blockProof, err := utils.BuildBlockProof(ctx, client.Child, receipt.BlockNumber, blockInfo.Start, blockInfo.End)
+	blockProof, err := utils.BuildBlockProof(ctx, client.Child, client.Child.config.RootHash, receipt.BlockNumber, blockInfo.Start, blockInfo.End)
 	if err != nil {
 		return nil, err
 	}
@@ -192,9 +316,75 @@ func (client *Client) BuildPayloadForExit(ctx context.Context, txHash common.Has
 	client.logger.Debug("ExitPayload", log.Fields{
 		"payload": hexutil.Encode(payload),
 	})
+
+	if err := client.VerifyExitPayload(ctx, payload); err != nil {
+		return nil, err
+	}
 	return payload, nil
 }
 
+// VerifyExitPayload : check the receipt proof against the receipts root of the child block and
+// the block proof against the checkpoint root of headerBlocks, as RootChainManager.exit does
+func (client *Client) VerifyExitPayload(ctx context.Context, payload []byte) error {
+	ctx = types.StickyContext(ctx)
+	exitPayload, err := types.DecodeExitPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	header, err := client.Child.HeaderByNumber(ctx, new(big.Int).SetUint64(exitPayload.BlockNumber))
+	if err != nil {
+		return err
+	}
+
+	if header.ReceiptHash != exitPayload.ReceiptRoot {
+		return fmt.Errorf("receipts root mismatch of block %d: payload %s, header %s", exitPayload.BlockNumber, exitPayload.ReceiptRoot, header.ReceiptHash)
+	}
+
+	if header.TxHash != exitPayload.TxRoot {
+		return fmt.Errorf("tx root mismatch of block %d: payload %s, header %s", exitPayload.BlockNumber, exitPayload.TxRoot, header.TxHash)
+	}
+
+	if header.Time != exitPayload.BlockTime {
+		return fmt.Errorf("block time mismatch of block %d: payload %d, header %d", exitPayload.BlockNumber, exitPayload.BlockTime, header.Time)
+	}
+
+	if len(exitPayload.BranchMask) == 0 {
+		return fmt.Errorf("empty branch mask")
+	}
+
+	receipt, err := utils.VerifyReceiptProof(exitPayload.ReceiptRoot, exitPayload.BranchMask[1:], exitPayload.ReceiptProof)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(receipt, exitPayload.Receipt) {
+		return fmt.Errorf("receipt mismatch: proven receipt differs from payload receipt")
+	}
+
+	headerBlock, err := client.Root.HeaderBlock(ctx, new(big.Int).SetUint64(exitPayload.HeaderNumber))
+	if err != nil {
+		return err
+	}
+	checkpointRoot := headerBlock.Root
+	start := headerBlock.Start
+
+	if start.Uint64() > exitPayload.BlockNumber {
+		return fmt.Errorf("block %d before checkpoint %d start %s", exitPayload.BlockNumber, exitPayload.HeaderNumber, start)
+	}
+
+	leaf := utils.BlockProofLeaf(exitPayload.BlockNumber, exitPayload.BlockTime, exitPayload.TxRoot, exitPayload.ReceiptRoot)
+	if err := utils.VerifyBlockProof(leaf, exitPayload.BlockNumber-start.Uint64(), checkpointRoot, exitPayload.BlockProof); err != nil {
+		return fmt.Errorf("checkpoint %d: %w", exitPayload.HeaderNumber, err)
+	}
+
+	client.Logger().Debug("VerifyExitPayload", log.Fields{
+		"headerNumber": exitPayload.HeaderNumber,
+		"blockNumber":  exitPayload.BlockNumber,
+	})
+	return nil
+}
+
 func (client *Client) IsCheckPointed(ctx context.Context, txHash common.Hash) (bool, error) {
 	client.Logger().Debug("IsCheckPointed", log.Fields{
 		"txHash": txHash,
@@ -227,3 +417,79 @@ func (client *Client) IsCheckPointed(ctx context.Context, txHash common.Hash) (b
 		return false, nil
 	}
 }
+
+// StateSyncId : id of the StateSynced log emitted by StateSender in the root deposit tx
+func (client *Client) StateSyncId(ctx context.Context, rootTxHash common.Hash) (*big.Int, error) {
+	receipt, err := client.Root.TransactionReceipt(ctx, rootTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status == ether.ReceiptStatusFailed {
+		return nil, &types.TxFailedError{TxHash: rootTxHash, Receipt: receipt}
+	}
+
+	for _, receiptLog := range receipt.Logs {
+		if len(receiptLog.Topics) < 2 || receiptLog.Topics[0] != common.HexToHash(types.StateSynced) {
+			continue
+		}
+
+		if client.config.Root.StateSender != (common.Address{}) && receiptLog.Address != client.config.Root.StateSender {
+			continue
+		}
+
+		return receiptLog.Topics[1].Big(), nil
+	}
+
+	return nil, fmt.Errorf("state synced log not found: %s", rootTxHash)
+}
+
+// IsDeposited : state sync of the root deposit tx is committed on child by StateReceiver
+func (client *Client) IsDeposited(ctx context.Context, rootTxHash common.Hash) (bool, error) {
+	stateSyncId, err := client.StateSyncId(ctx, rootTxHash)
+	if err != nil {
+		return false, err
+	}
+
+	lastStateIdResp, err := utils.CallContract(ctx, client.Child, client.config.Child.StateReceiver, maticabi.StateReceiver,
+		"lastStateId",
+	)
+	if err != nil {
+		return false, err
+	}
+	lastStateId := lastStateIdResp[0].(*big.Int)
+
+	client.Logger().Debug("IsDeposited", log.Fields{
+		"rootTxHash":  rootTxHash,
+		"stateSyncId": stateSyncId,
+		"lastStateId": lastStateId,
+	})
+	return lastStateId.Cmp(stateSyncId) >= 0, nil
+}
+
+// WaitDeposit : block until IsDeposited, state syncs usually take 20 to 30 minutes after the deposit is mined
+func (client *Client) WaitDeposit(ctx context.Context, rootTxHash common.Hash) error {
+	if _, err := client.Root.WaitMined(ctx, rootTxHash); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(client.Child.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		deposited, err := client.IsDeposited(ctx, rootTxHash)
+		if err != nil {
+			return err
+		}
+
+		if deposited {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,14 +1,21 @@
 package pos
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/MinseokOh/matic-sdk-go/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
+	"time"
 )
 
 var (
@@ -31,22 +38,24 @@ var (
 	}
 )
 
+// TestExitPayload : exit payload of burn tx 0x4363c691323e4de0e7c4019f1bdbf3c1adc12d63217c7dc374344d4edbcef7c7 on mumbai
+const TestExitPayload = `0xf907d184329e38b0b90140e634843710b626db85ed306ba005aad4cc3e80f29a32561c3338c24a077873f15d14997a92263f5850b05b3e6eb518965615840368325722657326b73f17d52cce119d183550f4cde2f3f779f5cede3439ab7709fad3f875de81ddfad23979025a84a0483fa7778058f6b826b785bbeb32cc0596ccefc37edaa1f413af7fdcab5df4074cb52849665685f354f02cedad7de42802c1e90ab75162fccf9dbcb902542c78c6d0f752c1dcdce94807400531a5eda481fafb0352ee802e50369b40806bb42bd094e60f7b3e92f6cf9e22a739d3f44203a107774904acd2dd59ea197d0b367acf964b2f58f8dc18247120c55c3fa8ed124e6dcff492276e16f0c2212b9867cc5f7f196b93bae1e27e6320742445d290f2263827498b54fec539f756af94615498e87b01bc998aff1a270013813b743ea626e698409720c681b951b8ec8401fac93e846413fdb2a0e030fcc05d17ab10ddeeb3cd1d4ec15ece5c52e7469beb4428f4a704889bfe42a05fae9fe2a0f81fe71cc31e721f9333e39eece5cf55df379e0a7d8f9e3ecd372bb902eb02f902e701828804b9010000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000008000000000000000000000008000000000000000000000000008000000800000000000000040000100000000000000000000020000000000000000000800000040000000000080000010000000000000000000000000000000000000000000000000000000000080000000000000200000000000000000080000000000000000000000000000000000000000004000000002000001000001000000004100000000000000000000100000000020000000000000000000000000000000000000000000000000000000000000100000f901ddf89b94a6fa4fb5f76172d178d61b04b0ecd319c5d1c0aaf863a0ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa0000000000000000000000000f51d45a201978a5b9fd90ca6c97bb95dc66d3258a00000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000002386f26fc10000f9013d940000000000000000000000000000000000001010f884a04dfe1bbbcf077ddc3e01291eea2d5c70c2b422b415d95645b9adcfd678cb1d63a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000f51d45a201978a5b9fd90ca6c97bb95dc66d3258a00000000000000000000000003a22c8bc68e98b0faf40f349dd2b2890fae01484b8a000000000000000000000000000000000000000000000000000013cafa1470fc00000000000000000000000000000000000000000000000007c2961f6b186fc3a0000000000000000000000000000000000000000000003f86e15eec88a732cf70000000000000000000000000000000000000000000000007c282547103fec7a0000000000000000000000000000000000000000000003f86e172b782bba3cb7b90348f90345f851a0d74f2683d7a7e72c940d7aeb5562ec601e0ca8706c4be475d3bad7b17dcfef1280808080808080a05ece88778ea1c4b48f676e63d6ba51cf842cee61b4da75335c5fd8a69913a2cf8080808080808080f902ef30b902eb02f902e701828804b9010000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000008000000000000000000000008000000000000000000000000008000000800000000000000040000100000000000000000000020000000000000000000800000040000000000080000010000000000000000000000000000000000000000000000000000000000080000000000000200000000000000000080000000000000000000000000000000000000000004000000002000001000001000000004100000000000000000000100000000020000000000000000000000000000000000000000000000000000000000000100000f901ddf89b94a6fa4fb5f76172d178d61b04b0ecd319c5d1c0aaf863a0ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa0000000000000000000000000f51d45a201978a5b9fd90ca6c97bb95dc66d3258a00000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000002386f26fc10000f9013d940000000000000000000000000000000000001010f884a04dfe1bbbcf077ddc3e01291eea2d5c70c2b422b415d95645b9adcfd678cb1d63a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000f51d45a201978a5b9fd90ca6c97bb95dc66d3258a00000000000000000000000003a22c8bc68e98b0faf40f349dd2b2890fae01484b8a000000000000000000000000000000000000000000000000000013cafa1470fc00000000000000000000000000000000000000000000000007c2961f6b186fc3a0000000000000000000000000000000000000000000003f86e15eec88a732cf70000000000000000000000000000000000000000000000007c282547103fec7a0000000000000000000000000000000000000000000003f86e172b782bba3cb782008080`
+
 func TestClient_BuildPayloadForExit(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
 	t.Log(client.config)
-	assert.NoError(t, err)
 
 	txHash := common.HexToHash("0x4363c691323e4de0e7c4019f1bdbf3c1adc12d63217c7dc374344d4edbcef7c7")
 	payload, err := client.BuildPayloadForExit(context.Background(), txHash, types.ERC20Transfer, 0)
 	assert.NoError(t, err)
 
-	expectedPayload := `0xf907d184329e38b0b90140e634843710b626db85ed306ba005aad4cc3e80f29a32561c3338c24a077873f15d14997a92263f5850b05b3e6eb518965615840368325722657326b73f17d52cce119d183550f4cde2f3f779f5cede3439ab7709fad3f875de81ddfad23979025a84a0483fa7778058f6b826b785bbeb32cc0596ccefc37edaa1f413af7fdcab5df4074cb52849665685f354f02cedad7de42802c1e90ab75162fccf9dbcb902542c78c6d0f752c1dcdce94807400531a5eda481fafb0352ee802e50369b40806bb42bd094e60f7b3e92f6cf9e22a739d3f44203a107774904acd2dd59ea197d0b367acf964b2f58f8dc18247120c55c3fa8ed124e6dcff492276e16f0c2212b9867cc5f7f196b93bae1e27e6320742445d290f2263827498b54fec539f756af94615498e87b01bc998aff1a270013813b743ea626e698409720c681b951b8ec8401fac93e846413fdb2a0e030fcc05d17ab10ddeeb3cd1d4ec15ece5c52e7469beb4428f4a704889bfe42a05fae9fe2a0f81fe71cc31e721f9333e39eece5cf55df379e0a7d8f9e3ecd372bb902eb02f902e701828804b9010000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000008000000000000000000000008000000000000000000000000008000000800000000000000040000100000000000000000000020000000000000000000800000040000000000080000010000000000000000000000000000000000000000000000000000000000080000000000000200000000000000000080000000000000000000000000000000000000000004000000002000001000001000000004100000000000000000000100000000020000000000000000000000000000000000000000000000000000000000000100000f901ddf89b94a6fa4fb5f76172d178d61b04b0ecd319c5d1c0aaf863a0ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa0000000000000000000000000f51d45a201978a5b9fd90ca6c97bb95dc66d3258a00000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000002386f26fc10000f9013d940000000000000000000000000000000000001010f884a04dfe1bbbcf077ddc3e01291eea2d5c70c2b422b415d95645b9adcfd678cb1d63a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000f51d45a201978a5b9fd90ca6c97bb95dc66d3258a00000000000000000000000003a22c8bc68e98b0faf40f349dd2b2890fae01484b8a000000000000000000000000000000000000000000000000000013cafa1470fc00000000000000000000000000000000000000000000000007c2961f6b186fc3a0000000000000000000000000000000000000000000003f86e15eec88a732cf70000000000000000000000000000000000000000000000007c282547103fec7a0000000000000000000000000000000000000000000003f86e172b782bba3cb7b90348f90345f851a0d74f2683d7a7e72c940d7aeb5562ec601e0ca8706c4be475d3bad7b17dcfef1280808080808080a05ece88778ea1c4b48f676e63d6ba51cf842cee61b4da75335c5fd8a69913a2cf8080808080808080f902ef30b902eb02f902e701828804b9010000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000008000000000000000000000008000000000000000000000000008000000800000000000000040000100000000000000000000020000000000000000000800000040000000000080000010000000000000000000000000000000000000000000000000000000000080000000000000200000000000000000080000000000000000000000000000000000000000004000000002000001000001000000004100000000000000000000100000000020000000000000000000000000000000000000000000000000000000000000100000f901ddf89b94a6fa4fb5f76172d178d61b04b0ecd319c5d1c0aaf863a0ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa0000000000000000000000000f51d45a201978a5b9fd90ca6c97bb95dc66d3258a00000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000002386f26fc10000f9013d940000000000000000000000000000000000001010f884a04dfe1bbbcf077ddc3e01291eea2d5c70c2b422b415d95645b9adcfd678cb1d63a00000000000000000000000000000000000000000000000000000000000001010a0000000000000000000000000f51d45a201978a5b9fd90ca6c97bb95dc66d3258a00000000000000000000000003a22c8bc68e98b0faf40f349dd2b2890fae01484b8a000000000000000000000000000000000000000000000000000013cafa1470fc00000000000000000000000000000000000000000000000007c2961f6b186fc3a0000000000000000000000000000000000000000000003f86e15eec88a732cf70000000000000000000000000000000000000000000000007c282547103fec7a0000000000000000000000000000000000000000000003f86e172b782bba3cb782008080`
-	assert.Equal(t, expectedPayload, hexutil.Encode(payload))
+	assert.Equal(t, TestExitPayload, hexutil.Encode(payload))
 }
 
 func TestClient_DepositEtherFor(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	hash, err := client.DepositEtherFor(context.Background(), big.NewInt(123456789), TestTxOption)
 	assert.NoError(t, err)
@@ -55,9 +64,168 @@ func TestClient_DepositEtherFor(t *testing.T) {
 
 func TestClient_IsCheckPointed(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	checkPointed, err := client.IsCheckPointed(context.Background(), common.HexToHash("0xc55da852f91aad02018e92870cc440928c7ef4693e3fc5dcf8b31df58ae97f94"))
 	assert.NoError(t, err)
 	assert.Equal(t, checkPointed, true)
 }
+
+func TestClient_RootToken(t *testing.T) {
+	client, err := NewClient(NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	rootToken, err := client.RootToken(context.Background(), ChildDummyERC20)
+	assert.NoError(t, err)
+	assert.Equal(t, RootDummyERC20, rootToken)
+}
+
+func TestClient_WaitDeposit(t *testing.T) {
+	config := NewDefaultConfig(types.MainNet)
+	rootTxHash := common.HexToHash("0x01")
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_getTransactionReceipt": func(params []json.RawMessage) (interface{}, error) {
+			return &ether.Receipt{
+				Status: ether.ReceiptStatusSuccessful,
+				Logs: []*ether.Log{{
+					Address: config.Root.StateSender,
+					Topics: []common.Hash{
+						common.HexToHash(types.StateSynced),
+						common.BigToHash(big.NewInt(42)),
+						common.HexToHash("0x02"),
+					},
+				}},
+				TxHash:      rootTxHash,
+				BlockHash:   common.HexToHash("0x03"),
+				BlockNumber: big.NewInt(100),
+			}, nil
+		},
+	})
+
+	var lastStateId int64 = 40
+	child := newTestRPC(t, map[string]rpcHandler{
+		"eth_call": func(params []json.RawMessage) (interface{}, error) {
+			lastStateId++
+			return hexutil.Bytes(common.BigToHash(big.NewInt(lastStateId)).Bytes()), nil
+		},
+	})
+	client := newTestClient(t, root, child)
+	client.Child.WithPollInterval(time.Millisecond)
+
+	stateSyncId, err := client.StateSyncId(context.Background(), rootTxHash)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), stateSyncId)
+
+	assert.NoError(t, client.WaitDeposit(context.Background(), rootTxHash))
+	assert.Equal(t, int64(42), lastStateId)
+}
+
+func TestClient_VerifyExitPayload(t *testing.T) {
+	payload := hexutil.MustDecode(TestExitPayload)
+	exitPayload, err := types.DecodeExitPayload(payload)
+	assert.NoError(t, err)
+
+	// checkpoint root folded from the block proof, block is the first of the checkpoint
+	leaf := utils.BlockProofLeaf(exitPayload.BlockNumber, exitPayload.BlockTime, exitPayload.TxRoot, exitPayload.ReceiptRoot)
+	checkpointRoot := leaf.Bytes()
+	for i := 0; i < len(exitPayload.BlockProof); i += 32 {
+		checkpointRoot = crypto.Keccak256(checkpointRoot, exitPayload.BlockProof[i:i+32])
+	}
+
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_chainId": chainIdRPC,
+		"eth_call": func(params []json.RawMessage) (interface{}, error) {
+			// headerBlocks(headerNumber) = (root, start, end, createdAt, proposer)
+			return hexutil.Bytes(bytes.Join([][]byte{
+				checkpointRoot,
+				common.BigToHash(new(big.Int).SetUint64(exitPayload.BlockNumber)).Bytes(),
+				common.BigToHash(new(big.Int).SetUint64(exitPayload.BlockNumber + 255)).Bytes(),
+				common.BigToHash(big.NewInt(1)).Bytes(),
+				common.BigToHash(big.NewInt(0)).Bytes(),
+			}, nil)), nil
+		},
+	})
+	child := newTestRPC(t, map[string]rpcHandler{
+		"eth_getBlockByNumber": func(params []json.RawMessage) (interface{}, error) {
+			return &ether.Header{
+				Number:      new(big.Int).SetUint64(exitPayload.BlockNumber),
+				Time:        exitPayload.BlockTime,
+				TxHash:      exitPayload.TxRoot,
+				ReceiptHash: exitPayload.ReceiptRoot,
+				Difficulty:  big.NewInt(1),
+			}, nil
+		},
+	})
+	client := newTestClient(t, root, child)
+
+	assert.NoError(t, client.VerifyExitPayload(context.Background(), payload))
+
+	// receipt of another tx
+	tampered := exitPayload
+	tampered.Receipt = append([]byte{}, exitPayload.Receipt...)
+	tampered.Receipt[len(tampered.Receipt)-1] ^= 0x01
+	tamperedPayload, err := rlp.EncodeToBytes(tampered)
+	assert.NoError(t, err)
+	assert.ErrorContains(t, client.VerifyExitPayload(context.Background(), tamperedPayload), "receipt mismatch")
+
+	// block proof of another checkpoint
+	tampered = exitPayload
+	tampered.BlockProof = append([]byte{}, exitPayload.BlockProof...)
+	tampered.BlockProof[0] ^= 0x01
+	tamperedPayload, err = rlp.EncodeToBytes(tampered)
+	assert.NoError(t, err)
+	assert.ErrorContains(t, client.VerifyExitPayload(context.Background(), tamperedPayload), "invalid block proof")
+}
+
+func TestNewDefaultConfig(t *testing.T) {
+	config := NewDefaultConfig(types.MainNet)
+	assert.NoError(t, config.Validate())
+	assert.Equal(t, "https://rpc.ankr.com/eth", config.Root.Rpc)
+	assert.Equal(t, "https://rpc.ankr.com/polygon", config.Child.Rpc)
+	assert.Equal(t, common.HexToAddress("0x86E4Dc95c7FBdBf52e33D563BbDB00823894C287"), config.Root.RootChain)
+	assert.Equal(t, common.HexToAddress("0xA0c68C638235ee32657e8f720a23ceC1bFc77C77"), config.Root.RootChainManager)
+
+	// unsupported networks are empty and rejected by NewClient
+	_, err := NewClient(NewDefaultConfig(types.Network(4242)))
+	assert.ErrorContains(t, err, "Root.Rpc")
+}
+
+func TestNewClient_InvalidConfig(t *testing.T) {
+	config := NewDefaultConfig(types.MainNet)
+	config.Root.RootChainManager = common.Address{}
+
+	_, err := NewClient(config)
+	var configErr *types.ConfigError
+	assert.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "Root.RootChainManager", configErr.Field)
+
+	config = NewDefaultConfig(types.MainNet)
+	config.Child.Rpc = ""
+	_, err = NewClient(config)
+	assert.ErrorContains(t, err, "Child.Rpc")
+}
+
+func TestNewDefaultConfig_CustomNetwork(t *testing.T) {
+	devnet := types.Network(1337)
+
+	var contract types.Contract
+	contract.Main.RPC = "http://localhost:9545"
+	contract.Main.Contracts.RootChainProxy = "0x0000000000000000000000000000000000000a01"
+	contract.Main.Contracts.StateSender = "0x0000000000000000000000000000000000000a02"
+	contract.Matic.RPC = "http://localhost:8545"
+	contract.Matic.GenesisContracts.StateReceiver = "0x0000000000000000000000000000000000001001"
+
+	var configErr *types.ConfigError
+	assert.ErrorAs(t, utils.RegisterNetwork(devnet, contract), &configErr)
+	assert.Equal(t, "Main.POSContracts.RootChainManagerProxy", configErr.Field)
+
+	contract.Main.POSContracts.RootChainManagerProxy = "0x0000000000000000000000000000000000000a03"
+	assert.NoError(t, utils.RegisterNetwork(devnet, contract))
+
+	config := NewDefaultConfig(devnet)
+	assert.NoError(t, config.Validate())
+	assert.Equal(t, "http://localhost:9545", config.Root.Rpc)
+	assert.Equal(t, "http://localhost:8545", config.Child.Rpc)
+	assert.Equal(t, common.HexToAddress("0x0a01"), config.Root.RootChain)
+	assert.Equal(t, common.HexToAddress("0x0a03"), config.Root.RootChainManager)
+}
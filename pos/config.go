@@ -0,0 +1,432 @@
+package pos
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DefaultEnvPrefix : prefix of the environment variables read by LoadConfigFromEnv.
+// A field is read from prefix + its path in upper snake case, e.g. Root.RootChainManager from MATIC_ROOT_ROOT_CHAIN_MANAGER.
+const DefaultEnvPrefix = "MATIC_"
+
+// LoadConfig : config of a json or yaml file, yaml for .yaml and .yml, keys are the json tags of POSClientConfig.
+// When the file names a network its keys override NewDefaultConfig of the network.
+// Errors of the file name the offending key, e.g. root.rootChainManager.
+func LoadConfig(path string) (types.POSClientConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return types.POSClientConfig{}, err
+	}
+
+	var tree map[string]interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		tree, err = parseYAML(data)
+	default:
+		tree, err = parseJSON(data)
+	}
+	if err != nil {
+		return types.POSClientConfig{}, fmt.Errorf("config %s: %w", path, err)
+	}
+
+	var config types.POSClientConfig
+	if value, ok := tree["network"]; ok {
+		var network types.Network
+		text, _ := value.(string)
+		if err := network.UnmarshalText([]byte(text)); err != nil {
+			return types.POSClientConfig{}, fmt.Errorf("config %s: %w", path, &types.ConfigError{Field: "network", Reason: err.Error()})
+		}
+		defaults, err := defaultConfig(network)
+		if err != nil {
+			return types.POSClientConfig{}, fmt.Errorf("config %s: %w", path, &types.ConfigError{Field: "network", Reason: err.Error()})
+		}
+		config = defaults
+		delete(tree, "network")
+	}
+
+	if err := applyTree(reflect.ValueOf(&config).Elem(), tree, ""); err != nil {
+		return types.POSClientConfig{}, fmt.Errorf("config %s: %w", path, err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return types.POSClientConfig{}, fmt.Errorf("config %s: %w", path, renameField(err, fileKey))
+	}
+	return config, nil
+}
+
+// LoadConfigFromEnv : config of the environment variables of prefix, DefaultEnvPrefix when empty.
+// When prefix + NETWORK is set the variables override NewDefaultConfig of the network.
+func LoadConfigFromEnv(prefix string) (types.POSClientConfig, error) {
+	if prefix == "" {
+		prefix = DefaultEnvPrefix
+	}
+
+	var config types.POSClientConfig
+	if value, ok := os.LookupEnv(prefix + "NETWORK"); ok {
+		var network types.Network
+		if err := network.UnmarshalText([]byte(value)); err != nil {
+			return types.POSClientConfig{}, &types.ConfigError{Field: prefix + "NETWORK", Reason: err.Error()}
+		}
+		defaults, err := defaultConfig(network)
+		if err != nil {
+			return types.POSClientConfig{}, &types.ConfigError{Field: prefix + "NETWORK", Reason: err.Error()}
+		}
+		config = defaults
+	}
+
+	if err := ApplyEnv(&config, prefix); err != nil {
+		return types.POSClientConfig{}, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return types.POSClientConfig{}, renameField(err, func(field string) string {
+			return prefix + envName(field)
+		})
+	}
+	return config, nil
+}
+
+// ApplyEnv : override config with the environment variables of prefix that are set, config is not validated.
+// Fallbacks are read as values separated by commas and GasCeilings as method=gas pairs separated by commas.
+func ApplyEnv(config *types.POSClientConfig, prefix string) error {
+	return applyEnv(reflect.ValueOf(config).Elem(), "", prefix)
+}
+
+func applyEnv(value reflect.Value, path, prefix string) error {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			if err := applyEnv(value.Field(i), fieldPath, prefix); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := prefix + envName(fieldPath)
+		raw, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := setField(value.Field(i), raw); err != nil {
+			return &types.ConfigError{Field: name, Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+func setField(field reflect.Value, raw string) error {
+	if field.Kind() == reflect.Ptr {
+		value := reflect.New(field.Type().Elem())
+		if err := setField(value.Elem(), raw); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(raw))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid bool %q", raw)
+		}
+		field.SetBool(value)
+	case reflect.Int, reflect.Int64:
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		field.SetInt(value)
+	case reflect.Uint64:
+		value, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		field.SetUint(value)
+	case reflect.Float64:
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", raw)
+		}
+		field.SetFloat(value)
+	case reflect.Slice:
+		// value,value
+		var values []string
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		return setSlice(field, values)
+	case reflect.Map:
+		// method=gas,method=gas
+		values := reflect.MakeMap(field.Type())
+		for _, pair := range strings.Split(raw, ",") {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			key, value, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("invalid pair %q, want key=value", pair)
+			}
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if err := setField(elem, strings.TrimSpace(value)); err != nil {
+				return err
+			}
+			values.SetMapIndex(reflect.ValueOf(strings.TrimSpace(key)), elem)
+		}
+		field.Set(values)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
+
+func setSlice(field reflect.Value, values []string) error {
+	slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+	for i, value := range values {
+		if err := setField(slice.Index(i), value); err != nil {
+			return err
+		}
+	}
+	field.Set(slice)
+	return nil
+}
+
+// applyTree : set the fields of value keyed by the json tags in tree, leaves of tree are strings or string lists
+func applyTree(value reflect.Value, tree map[string]interface{}, path string) error {
+	fields := make(map[string]int, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		fields[strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]] = i
+	}
+
+	keys := make([]string, 0, len(tree))
+	for key := range tree {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		i, ok := fields[key]
+		if !ok {
+			return &types.ConfigError{Field: keyPath, Reason: "unknown key"}
+		}
+		field := value.Field(i)
+
+		switch node := tree[key].(type) {
+		case map[string]interface{}:
+			if field.Kind() == reflect.Struct {
+				if err := applyTree(field, node, keyPath); err != nil {
+					return err
+				}
+				continue
+			}
+			if field.Kind() != reflect.Map {
+				return &types.ConfigError{Field: keyPath, Reason: "want a value, got a map"}
+			}
+			values := reflect.MakeMap(field.Type())
+			for name, raw := range node {
+				text, ok := raw.(string)
+				if !ok {
+					return &types.ConfigError{Field: keyPath + "." + name, Reason: "want a value, got a map"}
+				}
+				elem := reflect.New(field.Type().Elem()).Elem()
+				if err := setField(elem, text); err != nil {
+					return &types.ConfigError{Field: keyPath + "." + name, Reason: err.Error()}
+				}
+				values.SetMapIndex(reflect.ValueOf(name), elem)
+			}
+			field.Set(values)
+		case []string:
+			if field.Kind() != reflect.Slice {
+				return &types.ConfigError{Field: keyPath, Reason: "want a value, got a list"}
+			}
+			if err := setSlice(field, node); err != nil {
+				return &types.ConfigError{Field: keyPath, Reason: err.Error()}
+			}
+		case string:
+			if field.Kind() == reflect.Struct {
+				return &types.ConfigError{Field: keyPath, Reason: "want a map, got a value"}
+			}
+			if err := setField(field, node); err != nil {
+				return &types.ConfigError{Field: keyPath, Reason: err.Error()}
+			}
+		}
+	}
+	return nil
+}
+
+// parseJSON : json object as a tree of string and string list leaves, null leaves are dropped
+func parseJSON(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return jsonTree(object, "")
+}
+
+func jsonTree(object map[string]interface{}, path string) (map[string]interface{}, error) {
+	tree := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		switch value := value.(type) {
+		case nil:
+		case map[string]interface{}:
+			node, err := jsonTree(value, keyPath)
+			if err != nil {
+				return nil, err
+			}
+			tree[key] = node
+		case string:
+			tree[key] = value
+		case json.Number:
+			tree[key] = value.String()
+		case bool:
+			tree[key] = strconv.FormatBool(value)
+		case []interface{}:
+			values := make([]string, 0, len(value))
+			for _, elem := range value {
+				switch elem := elem.(type) {
+				case string:
+					values = append(values, elem)
+				case json.Number:
+					values = append(values, elem.String())
+				default:
+					return nil, &types.ConfigError{Field: keyPath, Reason: "lists hold values only"}
+				}
+			}
+			tree[key] = values
+		}
+	}
+	return tree, nil
+}
+
+// parseYAML : yaml mapping as a tree of string and string list leaves, null leaves are dropped
+func parseYAML(data []byte) (map[string]interface{}, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	if len(document.Content) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	return yamlTree(document.Content[0], "")
+}
+
+func yamlTree(node *yaml.Node, path string) (map[string]interface{}, error) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: want a map", node.Line)
+	}
+
+	tree := make(map[string]interface{}, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i].Value, node.Content[i+1]
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		if value.Kind == yaml.AliasNode {
+			value = value.Alias
+		}
+
+		switch {
+		case value.Kind == yaml.MappingNode:
+			child, err := yamlTree(value, keyPath)
+			if err != nil {
+				return nil, err
+			}
+			tree[key] = child
+		case value.Kind == yaml.ScalarNode && value.Tag == "!!null":
+		case value.Kind == yaml.ScalarNode:
+			tree[key] = value.Value
+		case value.Kind == yaml.SequenceNode:
+			values := make([]string, 0, len(value.Content))
+			for _, elem := range value.Content {
+				if elem.Kind != yaml.ScalarNode {
+					return nil, &types.ConfigError{Field: keyPath, Reason: fmt.Sprintf("line %d: lists hold values only", elem.Line)}
+				}
+				values = append(values, elem.Value)
+			}
+			tree[key] = values
+		default:
+			return nil, &types.ConfigError{Field: keyPath, Reason: fmt.Sprintf("line %d: unsupported value", value.Line)}
+		}
+	}
+	return tree, nil
+}
+
+// renameField : ConfigError of err with its field renamed from the go path
+func renameField(err error, rename func(field string) string) error {
+	var configError *types.ConfigError
+	if !errors.As(err, &configError) {
+		return err
+	}
+	return &types.ConfigError{Field: rename(configError.Field), Reason: configError.Reason}
+}
+
+// fileKey : Root.RootChainManager to root.rootChainManager
+func fileKey(field string) string {
+	parts := strings.Split(field, ".")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToLower(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// envName : Root.RootChainManager to ROOT_ROOT_CHAIN_MANAGER
+func envName(field string) string {
+	var name strings.Builder
+	for _, part := range strings.Split(field, ".") {
+		if name.Len() > 0 {
+			name.WriteByte('_')
+		}
+		runes := []rune(part)
+		for i, r := range runes {
+			if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				name.WriteByte('_')
+			}
+			name.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return name.String()
+}
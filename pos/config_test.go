@@ -0,0 +1,164 @@
+package pos
+
+import (
+	"errors"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestConfig(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func assertConfigError(t *testing.T, err error, field string) {
+	var configError *types.ConfigError
+	if assert.True(t, errors.As(err, &configError), "%v", err) {
+		assert.Equal(t, field, configError.Field)
+	}
+}
+
+func TestLoadConfig_JSON(t *testing.T) {
+	path := writeTestConfig(t, "config.json", `{
+	"network": "mainnet",
+	"root": {"rpc": "http://root.local", "checkpointInterval": 1},
+	"child": {"rpc": "http://child.local", "rootHash": "local"},
+	"debug": {"enable": false, "level": "warning"},
+	"gas": {"txType": 2, "gasMultiplier": 1.2, "gasCeilings": {"exit": 3000000}, "minGasTipCap": 30000000000},
+	"signer": {"privateKeyEnv": "EXIT_KEY"}
+}`)
+
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+
+	defaults := NewDefaultConfig(types.MainNet)
+	assert.Equal(t, "http://root.local", config.Root.Rpc)
+	assert.Equal(t, "http://child.local", config.Child.Rpc)
+	assert.Equal(t, defaults.Root.RootChainManager, config.Root.RootChainManager)
+	assert.Equal(t, defaults.Child.StateReceiver, config.Child.StateReceiver)
+	assert.Equal(t, uint64(1), config.Root.CheckpointInterval)
+	assert.Equal(t, types.RootHashLocal, config.Child.RootHash)
+	assert.Equal(t, types.WarnLevel, config.Debug.Level)
+	assert.False(t, config.Debug.Enable)
+	assert.Equal(t, types.DynamicFeeTxType, config.Gas.TxType)
+	assert.Equal(t, 1.2, config.Gas.GasMultiplier)
+	assert.Equal(t, uint64(3000000), config.Gas.GasCeilings["exit"])
+	assert.Equal(t, big.NewInt(30000000000), config.Gas.MinGasTipCap)
+	assert.Equal(t, "EXIT_KEY", config.Signer.PrivateKeyEnv)
+}
+
+func TestLoadConfig_YAML(t *testing.T) {
+	path := writeTestConfig(t, "config.yaml", `
+root:
+  rpc: http://root.local
+  fallbacks:
+    - http://root-a.local
+    - https://root-b.local
+  rootChain: "0x2890bA17EfE978480615e330ecB65333b880928e"
+  rootChainManager: "0xBbD7cBFA79faee899Eaf900F13C9065bF03B1A74"
+  stateSender: "0xEAa852323826C71cd7920C3b4c007184234c3945"
+child:
+  rpc: http://child.local
+  stateReceiver: "0x0000000000000000000000000000000000001001"
+gas:
+  tipPercentile: 60
+  autoAccessList: true
+  txType: 1
+`)
+
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0xBbD7cBFA79faee899Eaf900F13C9065bF03B1A74"), config.Root.RootChainManager)
+	assert.Equal(t, common.HexToAddress("0x1001"), config.Child.StateReceiver)
+	assert.Equal(t, []string{"http://root.local", "http://root-a.local", "https://root-b.local"}, config.Root.Endpoints())
+	assert.Equal(t, types.RootHashAuto, config.Child.RootHash)
+	assert.Equal(t, float64(60), config.Gas.TipPercentile)
+	assert.Equal(t, types.AccessListTxType, config.Gas.TxType)
+	assert.True(t, config.Gas.AutoAccessList)
+}
+
+func TestLoadConfig_Errors(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		file    string
+		content string
+		field   string
+		err     string
+	}{
+		{"missing address", "config.json", `{"root": {"rpc": "http://root.local"}, "child": {"rpc": "http://child.local"}}`, "root.rootChain", ""},
+		{"bad address", "config.json", `{"network": "mainnet", "root": {"stateSender": 12}}`, "root.stateSender", ""},
+		{"bad gas", "config.json", `{"network": "mainnet", "gas": {"tipPercentile": 101}}`, "gas.tipPercentile", ""},
+		{"signer sources", "config.yml", "network: mainnet\nsigner:\n  keystore: key.json\n  mnemonicEnv: MNEMONIC\n", "signer.mnemonicEnv", ""},
+		{"short address", "config.yaml", "network: mainnet\nroot:\n  stateSender: \"0x12\"\n", "root.stateSender", "want 40"},
+		{"bad level", "config.json", `{"network": "mainnet", "debug": {"level": "loud"}}`, "debug.level", "loud"},
+		{"bad ceiling", "config.yaml", "network: mainnet\ngas:\n  gasCeilings:\n    exit: lots\n", "gas.gasCeilings.exit", "lots"},
+		{"unknown key", "config.json", `{"network": "mainnet", "root": {"rpcUrl": "http://root.local"}}`, "root.rpcUrl", "unknown key"},
+		{"unknown yaml key", "config.yaml", "network: mainnet\nchild:\n  rpcUrl: http://child.local\n", "child.rpcUrl", "unknown key"},
+		{"bad root hash", "config.yaml", "network: mainnet\nchild:\n  rootHash: remote\n", "child.rootHash", "remote"},
+		{"bad network", "config.json", `{"network": "ropsten"}`, "network", "ropsten"},
+		{"unsupported network", "config.json", `{"network": 4242}`, "network", "unsupported network"},
+		{"list", "config.yaml", "network: mainnet\nroot:\n  rpc: [http://root.local]\n", "root.rpc", "got a list"},
+		{"bad fallback", "config.json", `{"network": "mainnet", "child": {"fallbacks": ["ws://child.local"]}}`, "child.fallbacks", "ws://child.local"},
+		{"malformed", "config.json", `{"network": "mainnet",}`, "", "invalid character"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			path := writeTestConfig(t, test.file, test.content)
+			_, err := LoadConfig(path)
+			assert.ErrorContains(t, err, path)
+			if test.field != "" {
+				assertConfigError(t, err, test.field)
+			}
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	t.Setenv("MATIC_NETWORK", "mainnet")
+	t.Setenv("MATIC_ROOT_RPC", "http://root.local")
+	t.Setenv("MATIC_CHILD_ROOT_HASH", "rpc")
+	t.Setenv("MATIC_CHILD_FALLBACKS", "http://child-a.local, http://child-b.local")
+	t.Setenv("MATIC_DEBUG_ENABLE", "false")
+	t.Setenv("MATIC_GAS_GAS_CEILINGS", "exit=3000000, withdraw=500000")
+	t.Setenv("MATIC_GAS_MIN_GAS_TIP_CAP", "25000000000")
+	t.Setenv("MATIC_SIGNER_REMOTE", "http://signer.local")
+	t.Setenv("MATIC_SIGNER_REMOTE_ADDRESS", "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	config, err := LoadConfigFromEnv("")
+	assert.NoError(t, err)
+
+	defaults := NewDefaultConfig(types.MainNet)
+	assert.Equal(t, "http://root.local", config.Root.Rpc)
+	assert.Equal(t, defaults.Child.Rpc, config.Child.Rpc)
+	assert.Equal(t, defaults.Root.RootChain, config.Root.RootChain)
+	assert.Equal(t, types.RootHashRPC, config.Child.RootHash)
+	assert.Equal(t, []string{"http://child-a.local", "http://child-b.local"}, config.Child.Fallbacks)
+	assert.False(t, config.Debug.Enable)
+	assert.Equal(t, map[string]uint64{"exit": 3000000, "withdraw": 500000}, config.Gas.GasCeilings)
+	assert.Equal(t, big.NewInt(25000000000), config.Gas.MinGasTipCap)
+	assert.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), config.Signer.RemoteAddress)
+
+	// custom prefix, field names of the errors are environment variables
+	t.Setenv("EXIT_ROOT_RPC", "http://root.local")
+	t.Setenv("EXIT_CHILD_RPC", "http://child.local")
+	_, err = LoadConfigFromEnv("EXIT_")
+	assertConfigError(t, err, "EXIT_ROOT_ROOT_CHAIN")
+
+	t.Setenv("EXIT_NETWORK", "mainnet")
+	t.Setenv("EXIT_GAS_TX_TYPE", "dynamic")
+	_, err = LoadConfigFromEnv("EXIT_")
+	assertConfigError(t, err, "EXIT_GAS_TX_TYPE")
+
+	t.Setenv("EXIT_GAS_TX_TYPE", "2")
+	t.Setenv("EXIT_GAS_GAS_MULTIPLIER", "0.5")
+	_, err = LoadConfigFromEnv("EXIT_")
+	assertConfigError(t, err, "EXIT_GAS_GAS_MULTIPLIER")
+}
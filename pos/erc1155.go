@@ -0,0 +1,322 @@
+package pos
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type ERC1155 struct {
+	*BaseToken
+}
+
+func newERC1155(client *Client, address common.Address, networkType types.NetworkType) *ERC1155 {
+	return &ERC1155{
+		BaseToken: newBaseToken(client, address, networkType, types.ERC1155),
+	}
+}
+
+// ApproveAll : approve all tokens to spender, when spender is zero address, approve to predicate address
+func (erc1155 *ERC1155) ApproveAll(ctx context.Context, spender common.Address, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("ApproveAll", log.Fields{
+		"spender":  spender,
+		"contract": erc1155.address.String(),
+	})
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if spender == (common.Address{}) {
+		spender = erc1155.PredicateAddress()
+	}
+
+	data, err := maticabi.ERC1155.Pack("setApprovalForAll", spender, true)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(erc1155.address, data, big.NewInt(0)).Build(ctx, erc1155.getClient())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = erc1155.getClient().SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc1155.Logger().Debug("ApproveAll", log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
+
+func (erc1155 *ERC1155) IsApprovedAll(ctx context.Context, address common.Address) (bool, error) {
+	erc1155.Logger().Debug("IsApprovedAll", log.Fields{
+		"address":  address,
+		"contract": erc1155.address,
+	})
+
+	if err := erc1155.checkForRoot("IsApprovedAll"); err != nil {
+		return false, err
+	}
+
+	isApprovedForAllResp, err := utils.CallContract(ctx, erc1155.getClient(), erc1155.address, maticabi.ERC1155,
+		"isApprovedForAll",
+		address,
+		erc1155.PredicateAddress(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return isApprovedForAllResp[0].(bool), nil
+}
+
+func (erc1155 *ERC1155) Deposit(ctx context.Context, tokenId, amount *big.Int, data []byte, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("Deposit", log.Fields{
+		"tokenId":  tokenId,
+		"amount":   amount,
+		"contract": erc1155.address.String(),
+	})
+
+	return erc1155.DepositMany(ctx, []*big.Int{tokenId}, []*big.Int{amount}, data, txOption)
+}
+
+func (erc1155 *ERC1155) DepositMany(ctx context.Context, tokenIds, amounts []*big.Int, data []byte, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("DepositMany", log.Fields{
+		"tokenIds": tokenIds,
+		"amounts":  amounts,
+		"contract": erc1155.address.String(),
+	})
+
+	if err := erc1155.checkForRoot("DepositMany"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := erc1155.validateMany(tokenIds, amounts); err != nil {
+		return common.Hash{}, err
+	}
+
+	if data == nil {
+		data = []byte{}
+	}
+
+	depositData, err := maticabi.DepositManyAmounts.Pack(tokenIds, amounts, data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash, err := erc1155.deposit(ctx, depositData, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return txHash, nil
+}
+
+func (erc1155 *ERC1155) validateMany(tokenIds, amounts []*big.Int) error {
+	if len(tokenIds) == 0 {
+		return fmt.Errorf("empty token ids")
+	}
+	if len(tokenIds) != len(amounts) {
+		return fmt.Errorf("token ids and amounts length mismatch: %d != %d", len(tokenIds), len(amounts))
+	}
+	return nil
+}
+
+func (erc1155 *ERC1155) Withdraw(ctx context.Context, tokenId, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("Withdraw", log.Fields{
+		"tokenId":  tokenId,
+		"amount":   amount,
+		"contract": erc1155.address,
+	})
+
+	if err := erc1155.checkForChild("Withdraw"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.ERC1155.Pack("withdrawSingle", tokenId, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(erc1155.address, data, big.NewInt(0)).Build(ctx, erc1155.getClient())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = erc1155.getClient().SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc1155.Logger().Debug("Withdraw", log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
+
+func (erc1155 *ERC1155) WithdrawMany(ctx context.Context, tokenIds, amounts []*big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("WithdrawMany", log.Fields{
+		"tokenIds": tokenIds,
+		"amounts":  amounts,
+		"contract": erc1155.address,
+	})
+
+	if err := erc1155.checkForChild("WithdrawMany"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := erc1155.validateMany(tokenIds, amounts); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.ERC1155.Pack("withdrawBatch", tokenIds, amounts)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(erc1155.address, data, big.NewInt(0)).Build(ctx, erc1155.getClient())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = erc1155.getClient().SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc1155.Logger().Debug("WithdrawMany", log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
+
+func (erc1155 *ERC1155) Exit(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("Exit", log.Fields{
+		"txHash": txHash,
+	})
+
+	if err := erc1155.checkForRoot("Exit"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	checkPointed, err := erc1155.client.IsCheckPointed(ctx, txHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if !checkPointed {
+		return common.Hash{}, fmt.Errorf("not checkpointed tx: %s", txHash.String())
+	}
+
+	payload, err := erc1155.client.BuildPayloadForExit(ctx, txHash, types.ERC1155Transfer, 0)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := erc1155.exit(ctx, payload, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc1155.Logger().Debug("Exit", log.Fields{
+		"txHash": hash,
+	})
+
+	return hash, nil
+}
+
+func (erc1155 *ERC1155) ExitMany(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	erc1155.Logger().Debug("ExitMany", log.Fields{
+		"txHash": txHash,
+	})
+
+	if err := erc1155.checkForRoot("ExitMany"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	checkPointed, err := erc1155.client.IsCheckPointed(ctx, txHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if !checkPointed {
+		return common.Hash{}, fmt.Errorf("not checkpointed tx: %s", txHash.String())
+	}
+
+	payload, err := erc1155.client.BuildPayloadForExit(ctx, txHash, types.ERC1155BatchTransfer, 0)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := erc1155.exit(ctx, payload, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc1155.Logger().Debug("ExitMany", log.Fields{
+		"txHash": hash,
+	})
+
+	return hash, nil
+}
+
+func (erc1155 *ERC1155) BalanceOf(ctx context.Context, address common.Address, tokenId *big.Int) (*big.Int, error) {
+	balanceOfResp, err := utils.CallContract(ctx, erc1155.getClient(), erc1155.address, maticabi.ERC1155, "balanceOf", address, tokenId)
+	if err != nil {
+		return nil, err
+	}
+	balance := balanceOfResp[0].(*big.Int)
+
+	erc1155.Logger().Debug("BalanceOf", log.Fields{
+		"balance": balance,
+	})
+
+	return balance, nil
+}
+
+func (erc1155 *ERC1155) BalanceOfBatch(ctx context.Context, addresses []common.Address, tokenIds []*big.Int) ([]*big.Int, error) {
+	if len(addresses) != len(tokenIds) {
+		return nil, fmt.Errorf("addresses and token ids length mismatch: %d != %d", len(addresses), len(tokenIds))
+	}
+
+	balanceOfBatchResp, err := utils.CallContract(ctx, erc1155.getClient(), erc1155.address, maticabi.ERC1155, "balanceOfBatch", addresses, tokenIds)
+	if err != nil {
+		return nil, err
+	}
+	balances := balanceOfBatchResp[0].([]*big.Int)
+
+	erc1155.Logger().Debug("BalanceOfBatch", log.Fields{
+		"balances": balances,
+	})
+
+	return balances, nil
+}
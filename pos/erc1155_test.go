@@ -0,0 +1,84 @@
+package pos
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func TestERC1155_Deposit(t *testing.T) {
+	client, err := NewClient(NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	hash, err := client.ERC1155(RootDummyERC1155, types.Root).Deposit(context.Background(), big.NewInt(123), big.NewInt(10), nil, TestTxOption)
+	assert.NoError(t, err)
+
+	t.Log("txHash", hash)
+}
+
+func TestERC1155_DepositMany(t *testing.T) {
+	client, err := NewClient(NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	ids := []*big.Int{
+		big.NewInt(123),
+		big.NewInt(124),
+	}
+	amounts := []*big.Int{
+		big.NewInt(10),
+		big.NewInt(20),
+	}
+	hash, err := client.ERC1155(RootDummyERC1155, types.Root).DepositMany(context.Background(), ids, amounts, nil, TestTxOption)
+	assert.NoError(t, err)
+
+	t.Log("txHash", hash)
+}
+
+func TestERC1155_DepositManyMismatch(t *testing.T) {
+	client, err := NewClient(NewDefaultConfig(types.MainNet))
+	require.NoError(t, err)
+
+	ids := []*big.Int{
+		big.NewInt(123),
+		big.NewInt(124),
+	}
+	_, err = client.ERC1155(RootDummyERC1155, types.Root).DepositMany(context.Background(), ids, []*big.Int{big.NewInt(10)}, nil, TestTxOption)
+	assert.Error(t, err)
+}
+
+func TestERC1155_IsApprovedAll(t *testing.T) {
+	client, err := NewClient(NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	approved, err := client.ERC1155(RootDummyERC1155, types.Root).IsApprovedAll(context.Background(), TestTxOption.From())
+	assert.NoError(t, err)
+
+	t.Log("approved", approved)
+}
+
+func TestERC1155_Withdraw(t *testing.T) {
+	client, err := NewClient(NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	erc1155 := client.ERC1155(ChildDummyERC1155, types.Child)
+	hash, err := erc1155.Withdraw(context.Background(), big.NewInt(123), big.NewInt(10), TestTxOption)
+	assert.NoError(t, err)
+	t.Log("txHash", hash.String())
+}
+
+func TestERC1155_BalanceOfBatch(t *testing.T) {
+	client, err := NewClient(NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	balances, err := client.ERC1155(ChildDummyERC1155, types.Child).BalanceOfBatch(context.Background(),
+		[]common.Address{TestTxOption.From(), TestTxOption.From()},
+		[]*big.Int{big.NewInt(123), big.NewInt(124)},
+	)
+	assert.NoError(t, err)
+
+	t.Log(balances)
+}
@@ -2,16 +2,20 @@ package pos
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/MinseokOh/matic-sdk-go/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
 )
 
 func TestERC20_Approve(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20 := client.ERC20(RootDummyERC20, types.Root)
 	hash, err := erc20.Approve(context.Background(), common.Address{}, big.NewInt(123456789), TestTxOption)
@@ -21,7 +25,7 @@ func TestERC20_Approve(t *testing.T) {
 
 func TestERC20_ApproveMax(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20 := client.ERC20(RootDummyERC20, types.Root)
 	hash, err := erc20.ApproveMax(context.Background(), common.Address{}, TestTxOption)
@@ -31,7 +35,7 @@ func TestERC20_ApproveMax(t *testing.T) {
 
 func TestERC20_DepositFor(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20 := client.ERC20(RootDummyERC20, types.Root)
 	hash, err := erc20.Deposit(context.Background(), big.NewInt(123456789), TestTxOption)
@@ -41,7 +45,7 @@ func TestERC20_DepositFor(t *testing.T) {
 
 func TestERC20_Withdraw(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20 := client.ERC20(ChildDummyERC20, types.Child)
 	hash, err := erc20.Withdraw(context.Background(), big.NewInt(123456789), TestTxOption)
@@ -51,7 +55,7 @@ func TestERC20_Withdraw(t *testing.T) {
 
 func TestERC20_WithdrawMatic(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20 := client.ERC20(Matic, types.Child)
 	hash, err := erc20.Withdraw(context.Background(), big.NewInt(123456789), TestTxOption)
@@ -61,7 +65,7 @@ func TestERC20_WithdrawMatic(t *testing.T) {
 
 func TestERC20_WithdrawEther(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20 := client.ERC20(ChildWETH, types.Child)
 	hash, err := erc20.Withdraw(context.Background(), big.NewInt(123456789), TestTxOption)
@@ -72,7 +76,7 @@ func TestERC20_WithdrawEther(t *testing.T) {
 func TestERC20_Exit(t *testing.T) {
 	txHash := common.HexToHash("0xe2f5f63d36fea883fc2514e70f0f49a3c006e27e81a08acf8857da9104b15f50")
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20 := client.ERC20(RootDummyERC20, types.Root)
 	hash, err := erc20.Exit(context.Background(), txHash, TestTxOption)
@@ -83,7 +87,7 @@ func TestERC20_Exit(t *testing.T) {
 func TestERC20_ExitEther(t *testing.T) {
 	txHash := common.HexToHash("0xc55da852f91aad02018e92870cc440928c7ef4693e3fc5dcf8b31df58ae97f94")
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc20 := client.ERC20(RootDummyERC20, types.Root)
 	hash, err := erc20.Exit(context.Background(), txHash, TestTxOption)
@@ -93,7 +97,7 @@ func TestERC20_ExitEther(t *testing.T) {
 
 func TestERC20_Balance(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	rootBalance, err := client.ERC20(RootDummyERC20, types.Root).BalanceOf(context.Background(), TestTxOption.From())
 	assert.NoError(t, err)
@@ -103,3 +107,22 @@ func TestERC20_Balance(t *testing.T) {
 
 	t.Log(rootBalance, childBalance)
 }
+
+func TestERC20_ExitAlreadyProcessed(t *testing.T) {
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_call": func(params []json.RawMessage) (interface{}, error) {
+			// processedExits(exitHash) = true
+			return hexutil.Bytes(common.LeftPadBytes([]byte{1}, 32)), nil
+		},
+	})
+	client := newTestClient(t, root, root)
+
+	payload, err := rlp.EncodeToBytes(types.ExitPayload{
+		BlockNumber: 29214440,
+		BranchMask:  []byte{0x00, 0x80},
+	})
+	assert.NoError(t, err)
+
+	_, err = client.ERC20(RootDummyERC20, types.Root).exit(context.Background(), payload, TestTxOption)
+	assert.ErrorIs(t, err, types.ExitAlreadyProcessed)
+}
@@ -257,6 +257,43 @@ func (erc721 *ERC721) WithdrawMany(ctx context.Context, tokenIds []*big.Int, txO
 	return tx.Hash(), nil
 }
 
+// WithdrawWithMetadata : burn token on child and emit TransferWithMetadata, used by mintable tokens
+// so that the token can be minted on root with its metadata
+func (erc721 *ERC721) WithdrawWithMetadata(ctx context.Context, tokenId *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	erc721.Logger().Debug("WithdrawWithMetadata", log.Fields{
+		"tokenId":  tokenId,
+		"contract": erc721.address,
+	})
+
+	if err := erc721.checkForChild("WithdrawWithMetadata"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.ERC721.Pack("withdrawWithMetadata", tokenId)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(erc721.address, data, big.NewInt(0)).Build(ctx, erc721.getClient())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = erc721.getClient().SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc721.Logger().Debug("WithdrawWithMetadata", log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
+
 func (erc721 *ERC721) Exit(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
 	erc721.Logger().Debug("Exit", log.Fields{
 		"txHash": txHash,
@@ -342,3 +379,52 @@ func (erc721 *ERC721) ExitMany(ctx context.Context, txHash common.Hash, txOption
 
 	return hash, nil
 }
+
+// ExitWithMetadata : exit a burn made by WithdrawWithMetadata, only allowed for mintable tokens
+func (erc721 *ERC721) ExitWithMetadata(ctx context.Context, txHash common.Hash, txOption *types.TxOption) (common.Hash, error) {
+	erc721.Logger().Debug("ExitWithMetadata", log.Fields{
+		"txHash": txHash,
+	})
+
+	if err := erc721.checkForRoot("ExitWithMetadata"); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	mintable, err := erc721.IsMintable(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if !mintable {
+		return common.Hash{}, fmt.Errorf("exit with metadata allowed on mintable token: %s", erc721.address)
+	}
+
+	checkPointed, err := erc721.client.IsCheckPointed(ctx, txHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if !checkPointed {
+		return common.Hash{}, fmt.Errorf("not checkpointed tx: %s", txHash.String())
+	}
+
+	payload, err := erc721.client.BuildPayloadForExit(ctx, txHash, types.ERC721TransferWithMetadata, 0)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := erc721.exit(ctx, payload, txOption)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	erc721.Logger().Debug("ExitWithMetadata", log.Fields{
+		"txHash": hash,
+	})
+
+	return hash, nil
+}
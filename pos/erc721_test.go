@@ -5,13 +5,14 @@ import (
 	"github.com/MinseokOh/matic-sdk-go/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
 )
 
 func TestERC721_Deposit(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	hash, err := client.ERC721(RootDummyERC721, types.Root).Deposit(context.Background(), big.NewInt(800), TestTxOption)
 	assert.NoError(t, err)
@@ -21,7 +22,7 @@ func TestERC721_Deposit(t *testing.T) {
 
 func TestERC721_DepositMany(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	ids := []*big.Int{
 		big.NewInt(800),
@@ -35,7 +36,7 @@ func TestERC721_DepositMany(t *testing.T) {
 
 func TestERC721_IsApproved(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	approved, err := client.ERC721(RootDummyERC721, types.Root).IsApproved(context.Background(), big.NewInt(805))
 	assert.NoError(t, err)
@@ -45,7 +46,7 @@ func TestERC721_IsApproved(t *testing.T) {
 
 func TestERC721_IsApprovedAll(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	approved, err := client.ERC721(RootDummyERC721, types.Root).IsApprovedAll(context.Background(), TestTxOption.From())
 	assert.NoError(t, err)
@@ -55,7 +56,7 @@ func TestERC721_IsApprovedAll(t *testing.T) {
 
 func TestERC721_Withdraw(t *testing.T) {
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc721 := client.ERC721(ChildDummyERC721, types.Child)
 	hash, err := erc721.Withdraw(context.Background(), big.NewInt(801), TestTxOption)
@@ -66,7 +67,7 @@ func TestERC721_Withdraw(t *testing.T) {
 func TestERC721_Exit(t *testing.T) {
 	txHash := common.HexToHash("0x54f47c891b460369661e22e27eeb4afbbb5dd792c7c8b48cab758892c14ffe85")
 	client, err := NewClient(NewDefaultConfig(types.TestNet))
-	assert.NoError(t, err)
+	require.NoError(t, err)
 
 	erc721 := client.ERC721(RootDummyERC721, types.Root)
 	hash, err := erc721.Exit(context.Background(), txHash, TestTxOption)
@@ -0,0 +1,144 @@
+package pos
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"sync/atomic"
+	"testing"
+)
+
+// newReceiptTestBlock : child block of n txs with receipts matching its receipts root
+func newReceiptTestBlock(t *testing.T, n int) (*ether.Block, []*ether.Receipt) {
+	chainId := big.NewInt(80001)
+	txs := make([]*ether.Transaction, n)
+	receipts := make([]*ether.Receipt, n)
+	for i := 0; i < n; i++ {
+		tx, err := ether.SignNewTx(TestPrivateKey, ether.LatestSignerForChainID(chainId), &ether.DynamicFeeTx{
+			ChainID:   chainId,
+			Nonce:     uint64(i),
+			GasTipCap: big.NewInt(30e9),
+			GasFeeCap: big.NewInt(60e9),
+			Gas:       21000,
+			To:        &common.Address{},
+			Value:     big.NewInt(0),
+		})
+		assert.NoError(t, err)
+
+		txs[i] = tx
+		receipts[i] = &ether.Receipt{
+			Type:              tx.Type(),
+			Status:            ether.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(21000 * (i + 1)),
+			Logs:              []*ether.Log{},
+			TxHash:            tx.Hash(),
+			GasUsed:           21000,
+			BlockNumber:       big.NewInt(100),
+			TransactionIndex:  uint(i),
+		}
+	}
+
+	block := ether.NewBlock(&ether.Header{
+		Number:     big.NewInt(100),
+		Difficulty: big.NewInt(1),
+	}, txs, nil, receipts, trie.NewStackTrie(nil))
+
+	return block, receipts
+}
+
+func receiptsByHash(receipts []*ether.Receipt) map[common.Hash]*ether.Receipt {
+	byHash := make(map[common.Hash]*ether.Receipt, len(receipts))
+	for _, receipt := range receipts {
+		byHash[receipt.TxHash] = receipt
+	}
+	return byHash
+}
+
+func assertReceiptProof(t *testing.T, client *Client, block *ether.Block, receipt *ether.Receipt) {
+	path, proof, err := utils.GetReceiptProof(context.Background(), client.Child, receipt, block)
+	assert.NoError(t, err)
+
+	value, err := utils.VerifyReceiptProof(block.ReceiptHash(), path, proof)
+	assert.NoError(t, err)
+
+	raw, err := receipt.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, raw, value)
+}
+
+func TestGetReceiptProof_BlockReceipts(t *testing.T) {
+	block, receipts := newReceiptTestBlock(t, 5)
+
+	for _, method := range []string{"eth_getBlockReceipts", "eth_getTransactionReceiptsByBlock"} {
+		t.Run(method, func(t *testing.T) {
+			var calls int32
+			child := newTestRPC(t, map[string]rpcHandler{
+				method: func(params []json.RawMessage) (interface{}, error) {
+					atomic.AddInt32(&calls, 1)
+
+					// bor appends the state sync receipt, it is not part of the receipts root
+					stateSync := &ether.Receipt{
+						Status: ether.ReceiptStatusSuccessful,
+						Logs:   []*ether.Log{},
+						TxHash: utils.GetDerivedBorTxHash(utils.BorReceiptKey(block.NumberU64(), block.Hash())),
+					}
+					return append(append([]*ether.Receipt{}, receipts...), stateSync), nil
+				},
+			})
+			client := newTestClient(t, newTestRPC(t, nil), child)
+
+			assertReceiptProof(t, client, block, receipts[3])
+			assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestGetReceiptProof_BatchFallback(t *testing.T) {
+	block, receipts := newReceiptTestBlock(t, 5)
+	byHash := receiptsByHash(receipts)
+
+	batchSize := utils.ReceiptBatchSize
+	utils.ReceiptBatchSize = 2
+	t.Cleanup(func() { utils.ReceiptBatchSize = batchSize })
+
+	var calls int32
+	child := newTestRPC(t, map[string]rpcHandler{
+		"eth_getTransactionReceipt": func(params []json.RawMessage) (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+
+			var txHash common.Hash
+			assert.NoError(t, json.Unmarshal(params[0], &txHash))
+			return byHash[txHash], nil
+		},
+	})
+	client := newTestClient(t, newTestRPC(t, nil), child)
+
+	for _, receipt := range receipts {
+		assertReceiptProof(t, client, block, receipt)
+	}
+	assert.Equal(t, int32(len(receipts)*len(receipts)), atomic.LoadInt32(&calls))
+}
+
+func TestGetReceiptProof_MissingReceipt(t *testing.T) {
+	block, receipts := newReceiptTestBlock(t, 3)
+	byHash := receiptsByHash(receipts)
+	delete(byHash, receipts[1].TxHash)
+
+	child := newTestRPC(t, map[string]rpcHandler{
+		"eth_getTransactionReceipt": func(params []json.RawMessage) (interface{}, error) {
+			var txHash common.Hash
+			assert.NoError(t, json.Unmarshal(params[0], &txHash))
+			return byHash[txHash], nil
+		},
+	})
+	client := newTestClient(t, newTestRPC(t, nil), child)
+
+	_, _, err := utils.GetReceiptProof(context.Background(), client.Child, receipts[0], block)
+	assert.ErrorContains(t, err, receipts[1].TxHash.String())
+	assert.ErrorContains(t, err, "not found")
+}
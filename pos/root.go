@@ -5,10 +5,13 @@ import (
 	"github.com/MinseokOh/matic-sdk-go/types"
 	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
 	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	log "github.com/sirupsen/logrus"
 	"math/big"
+	"time"
 )
 
 type RootClient struct {
@@ -16,29 +19,71 @@ type RootClient struct {
 	config types.RootConfig
 	logger *types.Logger
 	rpc    *rpc.Client
+
+	endpoints    *types.EndpointPool
+	nonceManager *types.NonceManager
+	pollInterval time.Duration
+	checkpoints  *types.CheckpointCache
 }
 
 func NewRootClient(config types.POSClientConfig) (*RootClient, error) {
 	root := RootClient{
 		config: config.Root,
 		logger: types.NewLogger("root", config.Debug),
+
+		nonceManager: types.DefaultNonceManager,
+		pollInterval: types.DefaultPollInterval,
+		checkpoints:  types.DefaultCheckpointCache,
 	}
 	var err error
-	root.rpc, err = rpc.Dial(root.config.Rpc)
+	root.rpc, root.endpoints, err = dialEndpoints(root.config.Endpoints(), root.logger)
 	if err != nil {
 		return nil, err
 	}
 	root.Client = ethclient.NewClient(root.rpc)
 
 	root.Logger().Debug("NewRootClient", log.Fields{
-		"rpc": root.config.Rpc,
+		"rpc":       root.config.Rpc,
+		"fallbacks": root.config.Fallbacks,
 	})
 
 	return &root, nil
 }
 
-func (root *RootClient) Rpc() *rpc.Client      { return root.rpc }
-func (root *RootClient) Logger() *types.Logger { return root.logger }
+func (root *RootClient) Rpc() *rpc.Client                  { return root.rpc }
+func (root *RootClient) Logger() *types.Logger             { return root.logger }
+func (root *RootClient) NonceManager() *types.NonceManager { return root.nonceManager }
+
+// Endpoints : failover pool of Rpc and Fallbacks, nil without fallbacks
+func (root *RootClient) Endpoints() *types.EndpointPool { return root.endpoints }
+
+// CheckpointCache : header blocks cache of GetRootBlockInfo and HeaderBlock
+func (root *RootClient) CheckpointCache() *types.CheckpointCache { return root.checkpoints }
+
+// WithNonceManager : replace the process wide nonce manager
+func (root *RootClient) WithNonceManager(nonceManager *types.NonceManager) *RootClient {
+	root.nonceManager = nonceManager
+	return root
+}
+
+// WithPollInterval : receipt polling interval of WaitMined and WaitConfirmed
+func (root *RootClient) WithPollInterval(pollInterval time.Duration) *RootClient {
+	root.pollInterval = pollInterval
+	return root
+}
+
+// WithCheckpointCache : replace the process wide checkpoint cache, e.g. with types.NewFileCheckpointCache
+func (root *RootClient) WithCheckpointCache(checkpoints *types.CheckpointCache) *RootClient {
+	root.checkpoints = checkpoints
+	return root
+}
+
+// SendTransaction : send tx and report the result to the nonce manager
+func (root *RootClient) SendTransaction(ctx context.Context, tx *ether.Transaction) error {
+	err := root.Client.SendTransaction(ctx, tx)
+	root.nonceManager.Sent(tx, err)
+	return err
+}
 
 func (root *RootClient) GetRootBlockInfo(ctx context.Context, txBlockNumber *big.Int) (types.RootBlockInfo, error) {
 	root.Logger().Debug("GetRootBlockInfo",
@@ -47,25 +92,11 @@ func (root *RootClient) GetRootBlockInfo(ctx context.Context, txBlockNumber *big
 		},
 	)
 
-	rootBlockNumber, err := utils.FindRootBlockFromChild(ctx, root, txBlockNumber, root.config.RootChain)
+	headerBlock, err := utils.FindHeaderBlock(ctx, root, root.checkpoints, root.config, txBlockNumber)
 	if err != nil {
 		return types.RootBlockInfo{}, err
 	}
 
-	headerBlocksResp, err := utils.CallContract(ctx, root, root.config.RootChain, maticabi.RootChain,
-		"headerBlocks",
-		rootBlockNumber,
-	)
-	if err != nil {
-		return types.RootBlockInfo{}, err
-	}
-
-	headerBlock := types.RootBlockInfo{
-		HeaderBlockNumber: rootBlockNumber,
-		Start:             headerBlocksResp[1].(*big.Int),
-		End:               headerBlocksResp[2].(*big.Int),
-	}
-
 	root.Logger().Debug("RootBlockInfo",
 		log.Fields{
 			"headerBlock": headerBlock,
@@ -75,6 +106,11 @@ func (root *RootClient) GetRootBlockInfo(ctx context.Context, txBlockNumber *big
 	return headerBlock, nil
 }
 
+// HeaderBlock : header block of RootChain by header block number
+func (root *RootClient) HeaderBlock(ctx context.Context, headerBlockNumber *big.Int) (types.RootBlockInfo, error) {
+	return utils.GetHeaderBlock(ctx, root, root.checkpoints, headerBlockNumber, root.config.RootChain)
+}
+
 func (root *RootClient) GetLastChildBlock(ctx context.Context) (*big.Int, error) {
 	root.Logger().Debug("GetLastChildBlock", nil)
 
@@ -93,3 +129,14 @@ func (root *RootClient) GetLastChildBlock(ctx context.Context) (*big.Int, error)
 	)
 	return lastChildBlock, nil
 }
+
+// WaitMined : receipt of tx once mined, *types.TxFailedError when the receipt status is failed
+func (root *RootClient) WaitMined(ctx context.Context, txHash common.Hash) (*ether.Receipt, error) {
+	return utils.WaitMined(ctx, root, txHash, root.pollInterval)
+}
+
+// WaitConfirmed : receipt of tx once buried under confirmations blocks,
+// types.TxDropped or types.TxReplaced when the tx leaves the pool without being mined
+func (root *RootClient) WaitConfirmed(ctx context.Context, txHash common.Hash, confirmations uint64) (*ether.Receipt, error) {
+	return utils.WaitConfirmed(ctx, root, txHash, confirmations, root.pollInterval)
+}
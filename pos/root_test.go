@@ -0,0 +1,145 @@
+package pos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"sync/atomic"
+	"testing"
+)
+
+// newRootChainRPC : RootChain stand-in with checkpoints of 256 child blocks, header block ids in steps of interval
+func newRootChainRPC(t *testing.T, checkpoints, interval int64, calls *int32) rpcHandler {
+	return func(params []json.RawMessage) (interface{}, error) {
+		atomic.AddInt32(calls, 1)
+
+		var msg struct {
+			Data hexutil.Bytes `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(params[0], &msg))
+
+		method, err := maticabi.RootChain.MethodById(msg.Data[:4])
+		assert.NoError(t, err)
+
+		switch method.Name {
+		case "currentHeaderBlock":
+			return hexutil.Bytes(common.BigToHash(big.NewInt(checkpoints * interval)).Bytes()), nil
+		case "headerBlocks":
+			id := new(big.Int).SetBytes(msg.Data[4:36]).Int64() / interval
+			return hexutil.Bytes(bytes.Join([][]byte{
+				common.BigToHash(big.NewInt(id)).Bytes(),
+				common.BigToHash(big.NewInt((id - 1) * 256)).Bytes(),
+				common.BigToHash(big.NewInt(id*256 - 1)).Bytes(),
+				common.BigToHash(big.NewInt(1670000000 + id)).Bytes(),
+				common.BigToHash(big.NewInt(id)).Bytes(),
+			}, nil)), nil
+		}
+		t.Fatalf("unexpected call %s", method.Name)
+		return nil, nil
+	}
+}
+
+func TestRootClient_GetRootBlockInfo(t *testing.T) {
+	var calls int32
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_chainId": chainIdRPC,
+		"eth_call":    newRootChainRPC(t, 1000, 10000, &calls),
+	})
+	client := newTestClient(t, root, newTestRPC(t, nil))
+
+	for _, test := range []struct {
+		childBlockNumber int64
+		headerBlock      int64
+	}{
+		{0, 1},
+		{255, 1},
+		{256, 2},
+		{123456, 483},
+		{255999, 1000},
+	} {
+		blockInfo, err := client.Root.GetRootBlockInfo(context.Background(), big.NewInt(test.childBlockNumber))
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(test.headerBlock*10000), blockInfo.HeaderBlockNumber)
+		assert.Equal(t, (test.headerBlock-1)*256, blockInfo.Start.Int64())
+		assert.Equal(t, test.headerBlock*256-1, blockInfo.End.Int64())
+		assert.Equal(t, common.BigToHash(big.NewInt(test.headerBlock)), blockInfo.Root)
+		assert.Equal(t, common.BigToAddress(big.NewInt(test.headerBlock)), blockInfo.Proposer)
+		assert.Equal(t, 1670000000+test.headerBlock, blockInfo.CreatedAt.Int64())
+	}
+
+	// checkpoints found before are served from the cache
+	atomic.StoreInt32(&calls, 0)
+	blockInfo, err := client.Root.GetRootBlockInfo(context.Background(), big.NewInt(123400))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(4830000), blockInfo.HeaderBlockNumber)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	blockInfo, err = client.Root.HeaderBlock(context.Background(), big.NewInt(4830000))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(123392), blockInfo.Start)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	// not yet checkpointed
+	_, err = client.Root.GetRootBlockInfo(context.Background(), big.NewInt(256000))
+	assert.ErrorContains(t, err, "not checkpointed")
+}
+
+func TestRootClient_GetRootBlockInfo_CheckpointInterval(t *testing.T) {
+	var calls int32
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_chainId": chainIdRPC,
+		"eth_call":    newRootChainRPC(t, 40, 1, &calls),
+	})
+	child := newTestRPC(t, nil)
+
+	config := NewDefaultConfig(types.MainNet)
+	config.Root.Rpc = root.URL
+	config.Child.Rpc = child.URL
+	config.Root.CheckpointInterval = 1
+	config.Debug.Enable = false
+
+	client, err := NewClient(config)
+	assert.NoError(t, err)
+	client.Root.WithCheckpointCache(types.NewCheckpointCache())
+
+	blockInfo, err := client.Root.GetRootBlockInfo(context.Background(), big.NewInt(5000))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(20), blockInfo.HeaderBlockNumber)
+	assert.Equal(t, int64(4864), blockInfo.Start.Int64())
+}
+
+func TestRootClient_Fallbacks(t *testing.T) {
+	var calls int32
+	down := newTestRPC(t, nil)
+	down.Close()
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_chainId": chainIdRPC,
+		"eth_call":    newRootChainRPC(t, 1000, 10000, &calls),
+	})
+	child := newTestRPC(t, nil)
+
+	config := NewDefaultConfig(types.MainNet)
+	config.Root.Rpc = down.URL
+	config.Root.Fallbacks = []string{root.URL}
+	config.Child.Rpc = child.URL
+	config.Debug.Enable = false
+
+	client, err := NewClient(config)
+	assert.NoError(t, err)
+	client.Root.WithCheckpointCache(types.NewCheckpointCache())
+	assert.Nil(t, client.Child.Endpoints())
+
+	blockInfo, err := client.Root.GetRootBlockInfo(context.Background(), big.NewInt(123456))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(4830000), blockInfo.HeaderBlockNumber)
+
+	status := client.Root.Endpoints().Status()
+	assert.False(t, status[0].Healthy)
+	assert.True(t, status[1].Healthy)
+}
@@ -0,0 +1,100 @@
+package pos
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type rpcHandler func(params []json.RawMessage) (interface{}, error)
+
+type rpcMessage struct {
+	Version string            `json:"jsonrpc"`
+	Id      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+// newTestRPC : local json-rpc stand-in of a node, methods without handler answer method not found
+func newTestRPC(t *testing.T, handlers map[string]rpcHandler) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body json.RawMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+			var batch []rpcMessage
+			assert.NoError(t, json.Unmarshal(body, &batch))
+
+			responses := make([]map[string]interface{}, len(batch))
+			for i, message := range batch {
+				responses[i] = handleRPC(handlers, message)
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(responses))
+			return
+		}
+
+		var message rpcMessage
+		assert.NoError(t, json.Unmarshal(body, &message))
+		assert.NoError(t, json.NewEncoder(w).Encode(handleRPC(handlers, message)))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// chainIdRPC : eth_chainId of mainnet
+func chainIdRPC(params []json.RawMessage) (interface{}, error) {
+	return hexutil.Uint64(types.MainNet), nil
+}
+
+func handleRPC(handlers map[string]rpcHandler, message rpcMessage) map[string]interface{} {
+	response := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      message.Id,
+	}
+
+	handler, ok := handlers[message.Method]
+	if !ok {
+		response["error"] = map[string]interface{}{
+			"code":    -32601,
+			"message": fmt.Sprintf("the method %s does not exist/is not available", message.Method),
+		}
+		return response
+	}
+
+	result, err := handler(message.Params)
+	if err != nil {
+		response["error"] = map[string]interface{}{
+			"code":    -32000,
+			"message": err.Error(),
+		}
+		return response
+	}
+
+	response["result"] = result
+	return response
+}
+
+// newTestClient : pos client on top of local stand-ins, contracts of test net
+func newTestClient(t *testing.T, root, child *httptest.Server) *Client {
+	config := NewDefaultConfig(types.MainNet)
+	config.Root.Rpc = root.URL
+	config.Child.Rpc = child.URL
+	config.Debug.Enable = false
+
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	client.Root.WithNonceManager(types.NewNonceManager())
+	client.Child.WithNonceManager(types.NewNonceManager())
+	client.Root.WithCheckpointCache(types.NewCheckpointCache())
+	return client
+}
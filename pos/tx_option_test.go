@@ -0,0 +1,107 @@
+package pos
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestTxOption_AutoAccessList(t *testing.T) {
+	storageKey := common.HexToHash("0x01")
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_createAccessList": func(params []json.RawMessage) (interface{}, error) {
+			var args struct {
+				To   common.Address `json:"to"`
+				Data hexutil.Bytes  `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(params[0], &args))
+			assert.Equal(t, RootDummyERC20, args.To)
+
+			return map[string]interface{}{
+				"accessList": ether.AccessList{{Address: args.To, StorageKeys: []common.Hash{storageKey}}},
+				"gasUsed":    "0x5208",
+			}, nil
+		},
+	})
+	client := newTestClient(t, root, root)
+
+	nonce := uint64(0)
+	txOption := &types.TxOption{
+		PrivateKey:     TestPrivateKey,
+		TxType:         types.AccessListTxType,
+		AutoAccessList: true,
+		GasLimit:       100000,
+		GasPrice:       big.NewInt(1e9),
+		ChainId:        big.NewInt(5),
+		Nonce:          &nonce,
+	}
+	tx, err := txOption.SetTxData(RootDummyERC20, []byte{0x01}, big.NewInt(0)).Build(context.Background(), client.Root)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint8(ether.AccessListTxType), tx.Type())
+	assert.Equal(t, 1, tx.AccessList().StorageKeys())
+
+	txOption.TxType = types.LegacyTxType
+	_, err = txOption.Build(context.Background(), client.Root)
+	assert.Error(t, err)
+}
+
+func TestTxOption_ConcurrentSend(t *testing.T) {
+	var mu sync.Mutex
+	var sent []*ether.Transaction
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_getTransactionCount": func(params []json.RawMessage) (interface{}, error) {
+			return hexutil.Uint64(0), nil
+		},
+		"eth_sendRawTransaction": func(params []json.RawMessage) (interface{}, error) {
+			var raw hexutil.Bytes
+			assert.NoError(t, json.Unmarshal(params[0], &raw))
+			tx := new(ether.Transaction)
+			assert.NoError(t, tx.UnmarshalBinary(raw))
+
+			mu.Lock()
+			sent = append(sent, tx)
+			mu.Unlock()
+			return tx.Hash(), nil
+		},
+	})
+	client := newTestClient(t, root, root)
+
+	// one TxOption shared by every send
+	txOption := &types.TxOption{
+		PrivateKey: TestPrivateKey,
+		TxType:     types.LegacyTxType,
+		GasLimit:   100000,
+		GasPrice:   big.NewInt(1e9),
+		ChainId:    big.NewInt(5),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int64) {
+			defer wg.Done()
+			tx, err := txOption.SetTxData(common.BigToAddress(big.NewInt(i+1)), []byte{byte(i)}, big.NewInt(i)).Build(context.Background(), client.Root)
+			if assert.NoError(t, err) {
+				assert.NoError(t, client.Root.SendTransaction(context.Background(), tx))
+			}
+		}(int64(i))
+	}
+	wg.Wait()
+
+	nonces := make(map[uint64]bool)
+	for _, tx := range sent {
+		assert.Equal(t, common.BigToAddress(new(big.Int).Add(tx.Value(), big.NewInt(1))), *tx.To())
+		assert.Equal(t, []byte{byte(tx.Value().Int64())}, tx.Data())
+		nonces[tx.Nonce()] = true
+	}
+	assert.Len(t, sent, 20)
+	assert.Len(t, nonces, 20)
+}
@@ -0,0 +1,124 @@
+package pos
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newWaitTestTx(t *testing.T) *ether.Transaction {
+	chainId := big.NewInt(5)
+	tx, err := ether.SignNewTx(TestPrivateKey, ether.LatestSignerForChainID(chainId), &ether.DynamicFeeTx{
+		ChainID:   chainId,
+		Nonce:     3,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(2e9),
+		Gas:       21000,
+		To:        &common.Address{},
+		Value:     big.NewInt(0),
+	})
+	assert.NoError(t, err)
+	return tx
+}
+
+func newWaitTestReceipt(tx *ether.Transaction, status uint64, blockNumber int64) *ether.Receipt {
+	return &ether.Receipt{
+		Type:        tx.Type(),
+		Status:      status,
+		Logs:        []*ether.Log{},
+		TxHash:      tx.Hash(),
+		GasUsed:     21000,
+		BlockHash:   common.HexToHash("0x01"),
+		BlockNumber: big.NewInt(blockNumber),
+	}
+}
+
+func TestRootClient_WaitConfirmed(t *testing.T) {
+	tx := newWaitTestTx(t)
+	var blockNumber int64 = 100
+	var polls int32
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_getTransactionReceipt": func(params []json.RawMessage) (interface{}, error) {
+			// pending for the first poll
+			if atomic.AddInt32(&polls, 1) == 1 {
+				return nil, nil
+			}
+			return newWaitTestReceipt(tx, ether.ReceiptStatusSuccessful, 100), nil
+		},
+		"eth_getTransactionByHash": func(params []json.RawMessage) (interface{}, error) {
+			return tx, nil
+		},
+		"eth_blockNumber": func(params []json.RawMessage) (interface{}, error) {
+			blockNumber++
+			return hexutil.Uint64(blockNumber), nil
+		},
+	})
+	client := newTestClient(t, root, root)
+	client.Root.WithPollInterval(time.Millisecond)
+
+	receipt, err := client.Root.WaitConfirmed(context.Background(), tx.Hash(), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, tx.Hash(), receipt.TxHash)
+	assert.GreaterOrEqual(t, blockNumber, int64(104))
+}
+
+func TestRootClient_WaitMinedFailed(t *testing.T) {
+	tx := newWaitTestTx(t)
+	root := newTestRPC(t, map[string]rpcHandler{
+		"eth_getTransactionReceipt": func(params []json.RawMessage) (interface{}, error) {
+			return newWaitTestReceipt(tx, ether.ReceiptStatusFailed, 100), nil
+		},
+	})
+	client := newTestClient(t, root, root)
+
+	receipt, err := client.Root.WaitMined(context.Background(), tx.Hash())
+	var txFailedError *types.TxFailedError
+	assert.ErrorAs(t, err, &txFailedError)
+	assert.Equal(t, receipt, txFailedError.Receipt)
+}
+
+func TestRootClient_WaitMinedDropped(t *testing.T) {
+	for _, test := range []struct {
+		nonce    uint64
+		expected error
+	}{
+		{nonce: 3, expected: types.TxDropped},
+		{nonce: 4, expected: types.TxReplaced},
+	} {
+		tx := newWaitTestTx(t)
+		var lookups int32
+		nonce := test.nonce
+		root := newTestRPC(t, map[string]rpcHandler{
+			"eth_getTransactionReceipt": func(params []json.RawMessage) (interface{}, error) {
+				return nil, nil
+			},
+			"eth_getTransactionByHash": func(params []json.RawMessage) (interface{}, error) {
+				// seen once, then gone from the pool
+				if atomic.AddInt32(&lookups, 1) == 1 {
+					return tx, nil
+				}
+				return nil, nil
+			},
+			"eth_getTransactionCount": func(params []json.RawMessage) (interface{}, error) {
+				var address common.Address
+				assert.NoError(t, json.Unmarshal(params[0], &address))
+				assert.Equal(t, crypto.PubkeyToAddress(TestPrivateKey.PublicKey), address)
+				return hexutil.Uint64(nonce), nil
+			},
+		})
+		client := newTestClient(t, root, root)
+		client.Root.WithPollInterval(time.Millisecond)
+
+		_, err := client.Root.WaitMined(context.Background(), tx.Hash())
+		assert.ErrorIs(t, err, test.expected)
+	}
+}
@@ -0,0 +1,62 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"os"
+	"strings"
+)
+
+// FromConfig : signer referenced by config, secrets read from the environment variables it names.
+// EmptySigner when config references no signer.
+func FromConfig(ctx context.Context, config types.SignerConfig) (types.Signer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case config.PrivateKeyEnv != "":
+		hexKey, err := lookupEnv("Signer.PrivateKeyEnv", config.PrivateKeyEnv)
+		if err != nil {
+			return nil, err
+		}
+		privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil {
+			return nil, &types.ConfigError{Field: "Signer.PrivateKeyEnv", Reason: fmt.Sprintf("%s: %v", config.PrivateKeyEnv, err)}
+		}
+		return types.NewPrivateKeySigner(privateKey), nil
+
+	case config.Keystore != "":
+		passphrase := ""
+		if config.PassphraseEnv != "" {
+			passphrase = os.Getenv(config.PassphraseEnv)
+		}
+		return NewKeystoreSigner(config.Keystore, passphrase)
+
+	case config.MnemonicEnv != "":
+		mnemonic, err := lookupEnv("Signer.MnemonicEnv", config.MnemonicEnv)
+		if err != nil {
+			return nil, err
+		}
+		password := ""
+		if config.PassphraseEnv != "" {
+			password = os.Getenv(config.PassphraseEnv)
+		}
+		return NewMnemonicSigner(mnemonic, password, config.DerivationPath)
+
+	case config.Remote != "":
+		return NewRemoteSigner(ctx, config.Remote, config.RemoteAddress, config.RemoteMethod)
+	}
+
+	return nil, types.EmptySigner
+}
+
+func lookupEnv(field, name string) (string, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return "", &types.ConfigError{Field: field, Reason: fmt.Sprintf("environment variable %s is empty", name)}
+	}
+	return value, nil
+}
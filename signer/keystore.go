@@ -0,0 +1,27 @@
+package signer
+
+import (
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"io/ioutil"
+)
+
+// NewKeystoreSigner : decrypt geth keystore file (web3 secret storage) with passphrase
+func NewKeystoreSigner(keyFile, passphrase string) (*types.PrivateKeySigner, error) {
+	keyJson, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeystoreJsonSigner(keyJson, passphrase)
+}
+
+// NewKeystoreJsonSigner : decrypt geth keystore json with passphrase
+func NewKeystoreJsonSigner(keyJson []byte, passphrase string) (*types.PrivateKeySigner, error) {
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewPrivateKeySigner(key.PrivateKey), nil
+}
@@ -0,0 +1,96 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+	"math/big"
+)
+
+// DefaultDerivationPath : first account of BIP-44 ethereum path, m/44'/60'/0'/0/0
+const DefaultDerivationPath = "m/44'/60'/0'/0/0"
+
+// NewMnemonicSigner : derive key from BIP-39 mnemonic along BIP-44 derivation path,
+// DefaultDerivationPath is used when path is empty
+func NewMnemonicSigner(mnemonic, password, path string) (*types.PrivateKeySigner, error) {
+	privateKey, err := DeriveKey(mnemonic, password, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewPrivateKeySigner(privateKey), nil
+}
+
+// DeriveKey : BIP-32 private key derivation from BIP-39 seed
+func DeriveKey(mnemonic, password, path string) (*ecdsa.PrivateKey, error) {
+	if path == "" {
+		path = DefaultDerivationPath
+	}
+
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, password)
+	if err != nil {
+		return nil, err
+	}
+
+	key, chainCode, err := deriveChild([]byte("Bitcoin seed"), seed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range derivationPath {
+		data := make([]byte, 0, 37)
+		if index >= 0x80000000 {
+			data = append(data, 0x00)
+			data = append(data, math.PaddedBigBytes(key, 32)...)
+		} else {
+			privateKey, err := crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, crypto.CompressPubkey(&privateKey.PublicKey)...)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		key, chainCode, err = deriveChild(chainCode, data, key)
+		if err != nil {
+			return nil, fmt.Errorf("derive %s: %w", path, err)
+		}
+	}
+
+	return crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+}
+
+// deriveChild : HMAC-SHA512 step of BIP-32, parent is nil for master key
+func deriveChild(chainCode, data []byte, parent *big.Int) (*big.Int, []byte, error) {
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	key := new(big.Int).SetBytes(sum[:32])
+	if key.Cmp(n) >= 0 {
+		return nil, nil, fmt.Errorf("invalid derived key")
+	}
+
+	if parent != nil {
+		key.Add(key, parent).Mod(key, n)
+	}
+
+	if key.Sign() == 0 {
+		return nil, nil, fmt.Errorf("invalid derived key")
+	}
+
+	return key, sum[32:], nil
+}
@@ -0,0 +1,162 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+)
+
+const (
+	// ClefSignMethod : clef external api
+	ClefSignMethod = "account_signTransaction"
+	// EthSignMethod : geth, web3signer and most node style signers
+	EthSignMethod = "eth_signTransaction"
+)
+
+var ErrSignHashUnsupported = fmt.Errorf("remote signer does not sign raw hash")
+
+// RemoteSigner : delegates signing to an external daemon over json-rpc, key never enters the process
+type RemoteSigner struct {
+	rpc     *rpc.Client
+	address common.Address
+	method  string
+}
+
+// NewRemoteSigner : dial signer endpoint, method is ClefSignMethod when empty
+func NewRemoteSigner(ctx context.Context, endpoint string, address common.Address, method string) (*RemoteSigner, error) {
+	client, err := rpc.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemoteSignerWithClient(client, address, method), nil
+}
+
+func NewRemoteSignerWithClient(client *rpc.Client, address common.Address, method string) *RemoteSigner {
+	if method == "" {
+		method = ClefSignMethod
+	}
+
+	return &RemoteSigner{
+		rpc:     client,
+		address: address,
+		method:  method,
+	}
+}
+
+func (signer *RemoteSigner) Address() common.Address { return signer.address }
+
+// SignTx : request signature of tx, the returned tx must be the requested one signed by address
+func (signer *RemoteSigner) SignTx(ctx context.Context, tx *ether.Transaction, chainId *big.Int) (*ether.Transaction, error) {
+	var result json.RawMessage
+	if err := signer.rpc.CallContext(ctx, &result, signer.method, newRemoteTxArgs(signer.address, tx, chainId)); err != nil {
+		return nil, err
+	}
+
+	raw, err := decodeSignResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx := new(ether.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, err
+	}
+
+	if err := signer.verify(tx, signedTx, chainId); err != nil {
+		return nil, err
+	}
+
+	return signedTx, nil
+}
+
+// SignHash : signing daemons only sign EIP-191 prefixed data, raw hash signing is refused
+func (signer *RemoteSigner) SignHash(ctx context.Context, hash common.Hash) ([]byte, error) {
+	return nil, ErrSignHashUnsupported
+}
+
+func (signer *RemoteSigner) verify(tx, signedTx *ether.Transaction, chainId *big.Int) error {
+	txSigner := ether.LatestSignerForChainID(chainId)
+	if txSigner.Hash(tx) != txSigner.Hash(signedTx) {
+		return fmt.Errorf("remote signer returned different tx: %s", signedTx.Hash())
+	}
+
+	sender, err := ether.Sender(txSigner, signedTx)
+	if err != nil {
+		return err
+	}
+
+	if sender != signer.address {
+		return fmt.Errorf("remote signer sender mismatch: expected %s, got %s", signer.address, sender)
+	}
+
+	return nil
+}
+
+// remoteTxArgs : SendTxArgs of clef, also accepted by eth_signTransaction
+type remoteTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *ether.AccessList `json:"accessList,omitempty"`
+	ChainId              *hexutil.Big      `json:"chainId"`
+}
+
+func newRemoteTxArgs(from common.Address, tx *ether.Transaction, chainId *big.Int) remoteTxArgs {
+	args := remoteTxArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainId: (*hexutil.Big)(chainId),
+	}
+
+	switch tx.Type() {
+	case ether.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	if tx.Type() != ether.LegacyTxType {
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	}
+
+	return args
+}
+
+// decodeSignResult : {raw, tx} object of clef and geth, or raw hex string of web3signer
+func decodeSignResult(result json.RawMessage) ([]byte, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err == nil {
+		return raw, nil
+	}
+
+	var signResult struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := json.Unmarshal(result, &signResult); err != nil {
+		return nil, err
+	}
+
+	if len(signResult.Raw) == 0 {
+		return nil, fmt.Errorf("remote signer returned empty tx")
+	}
+
+	return signResult.Raw, nil
+}
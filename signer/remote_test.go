@@ -0,0 +1,126 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newSignerServer : local stand-in of signing daemon, signs requested tx with privateKey after tamper
+func newSignerServer(t *testing.T, privateKey *ecdsa.PrivateKey, tamper func(args *remoteTxArgs)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Id     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []remoteTxArgs  `json:"params"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, ClefSignMethod, request.Method)
+
+		args := request.Params[0]
+		if tamper != nil {
+			tamper(&args)
+		}
+
+		chainId := (*big.Int)(args.ChainId)
+		tx, err := ether.SignNewTx(privateKey, ether.LatestSignerForChainID(chainId), &ether.DynamicFeeTx{
+			ChainID:    chainId,
+			Nonce:      uint64(args.Nonce),
+			GasTipCap:  (*big.Int)(args.MaxPriorityFeePerGas),
+			GasFeeCap:  (*big.Int)(args.MaxFeePerGas),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      (*big.Int)(args.Value),
+			Data:       args.Data,
+			AccessList: *args.AccessList,
+		})
+		assert.NoError(t, err)
+
+		raw, err := tx.MarshalBinary()
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      request.Id,
+			"result": map[string]interface{}{
+				"raw": hexutil.Bytes(raw),
+				"tx":  tx,
+			},
+		}))
+	}))
+}
+
+func newTestTx(chainId *big.Int) *ether.Transaction {
+	return ether.NewTx(&ether.DynamicFeeTx{
+		ChainID:   chainId,
+		Nonce:     7,
+		GasTipCap: big.NewInt(30e9),
+		GasFeeCap: big.NewInt(60e9),
+		Gas:       100000,
+		To:        &common.Address{0x01},
+		Value:     big.NewInt(10000),
+		Data:      []byte{0xde, 0xad},
+	})
+}
+
+func TestRemoteSigner_SignTx(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+	server := newSignerServer(t, privateKey, nil)
+	defer server.Close()
+
+	signer, err := NewRemoteSigner(context.Background(), server.URL, address, "")
+	assert.NoError(t, err)
+
+	chainId := big.NewInt(80001)
+	tx := newTestTx(chainId)
+	signedTx, err := signer.SignTx(context.Background(), tx, chainId)
+	assert.NoError(t, err)
+
+	sender, err := ether.Sender(ether.LatestSignerForChainID(chainId), signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, address, sender)
+	assert.Equal(t, tx.Nonce(), signedTx.Nonce())
+
+	_, err = signer.SignHash(context.Background(), common.Hash{})
+	assert.ErrorIs(t, err, ErrSignHashUnsupported)
+}
+
+func TestRemoteSigner_SenderMismatch(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	server := newSignerServer(t, otherKey, nil)
+	defer server.Close()
+
+	signer, err := NewRemoteSigner(context.Background(), server.URL, crypto.PubkeyToAddress(privateKey.PublicKey), "")
+	assert.NoError(t, err)
+
+	chainId := big.NewInt(80001)
+	_, err = signer.SignTx(context.Background(), newTestTx(chainId), chainId)
+	assert.ErrorContains(t, err, "sender mismatch")
+}
+
+func TestRemoteSigner_TamperedTx(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	server := newSignerServer(t, privateKey, func(args *remoteTxArgs) {
+		args.Value = (*hexutil.Big)(big.NewInt(1e18))
+	})
+	defer server.Close()
+
+	signer, err := NewRemoteSigner(context.Background(), server.URL, crypto.PubkeyToAddress(privateKey.PublicKey), "")
+	assert.NoError(t, err)
+
+	chainId := big.NewInt(80001)
+	_, err = signer.SignTx(context.Background(), newTestTx(chainId), chainId)
+	assert.ErrorContains(t, err, "different tx")
+}
@@ -0,0 +1,109 @@
+package signer
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const TestMnemonic = "test test test test test test test test test test test junk"
+
+func TestNewMnemonicSigner(t *testing.T) {
+	signer, err := NewMnemonicSigner(TestMnemonic, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), signer.Address())
+
+	signer, err = NewMnemonicSigner(TestMnemonic, "", "m/44'/60'/0'/0/1")
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"), signer.Address())
+
+	_, err = NewMnemonicSigner("test test junk", "", "")
+	assert.Error(t, err)
+}
+
+func TestNewKeystoreSigner(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	keyJson, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	assert.NoError(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "key.json")
+	assert.NoError(t, os.WriteFile(keyFile, keyJson, 0600))
+
+	signer, err := NewKeystoreSigner(keyFile, "passphrase")
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), signer.Address())
+
+	_, err = NewKeystoreSigner(keyFile, "wrong")
+	assert.Error(t, err)
+}
+
+func TestPrivateKeySigner_SignTx(t *testing.T) {
+	signer, err := NewMnemonicSigner(TestMnemonic, "", "")
+	assert.NoError(t, err)
+
+	var s types.Signer = signer
+	chainId := big.NewInt(80001)
+	tx, err := s.SignTx(context.Background(), ether.NewTx(&ether.DynamicFeeTx{
+		ChainID:   chainId,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		Gas:       21000,
+		To:        &common.Address{},
+		Value:     big.NewInt(0),
+	}), chainId)
+	assert.NoError(t, err)
+
+	sender, err := ether.Sender(ether.LatestSignerForChainID(chainId), tx)
+	assert.NoError(t, err)
+	assert.Equal(t, s.Address(), sender)
+
+	hash := crypto.Keccak256Hash([]byte("matic"))
+	signature, err := s.SignHash(context.Background(), hash)
+	assert.NoError(t, err)
+
+	publicKey, err := crypto.SigToPub(hash.Bytes(), signature)
+	assert.NoError(t, err)
+	assert.Equal(t, s.Address(), crypto.PubkeyToAddress(*publicKey))
+}
+
+func TestFromConfig(t *testing.T) {
+	signer, err := FromConfig(context.Background(), types.SignerConfig{})
+	assert.ErrorIs(t, err, types.EmptySigner)
+	assert.Nil(t, signer)
+
+	t.Setenv("TEST_MNEMONIC", TestMnemonic)
+	signer, err = FromConfig(context.Background(), types.SignerConfig{
+		MnemonicEnv:    "TEST_MNEMONIC",
+		DerivationPath: "m/44'/60'/0'/0/1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"), signer.Address())
+
+	// hardhat account #0
+	t.Setenv("TEST_PRIVATE_KEY", "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	signer, err = FromConfig(context.Background(), types.SignerConfig{PrivateKeyEnv: "TEST_PRIVATE_KEY"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), signer.Address())
+
+	// secrets are never read from the config itself
+	_, err = FromConfig(context.Background(), types.SignerConfig{PrivateKeyEnv: "TEST_MISSING_KEY"})
+	assert.ErrorContains(t, err, "TEST_MISSING_KEY is empty")
+
+	_, err = FromConfig(context.Background(), types.SignerConfig{PrivateKeyEnv: "TEST_PRIVATE_KEY", MnemonicEnv: "TEST_MNEMONIC"})
+	assert.ErrorContains(t, err, "one signer source at most")
+}
@@ -0,0 +1,206 @@
+package staking
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/pos"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type Client struct {
+	pos    *pos.Client
+	config types.StakingConfig
+	logger *types.Logger
+}
+
+func NewDefaultConfig(network types.Network) (types.StakingConfig, error) {
+	contract, err := utils.GetContractByNetwork(network)
+	if err != nil {
+		return types.StakingConfig{}, err
+	}
+	return contract.StakingConfig(), nil
+}
+
+// NewClient : staking client on top of pos client, staking contracts live on root
+func NewClient(posClient *pos.Client, config types.StakingConfig) (*Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		pos:    posClient,
+		config: config,
+		logger: types.NewLogger("staking", posClient.Config().Debug),
+	}, nil
+}
+
+func (client *Client) Logger() *types.Logger { return client.logger }
+func (client *Client) POS() *pos.Client      { return client.pos }
+
+// ApproveStake : approve matic token to StakeManager, required before BuyVoucher
+func (client *Client) ApproveStake(ctx context.Context, amount *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	client.Logger().Debug("ApproveStake", log.Fields{
+		"amount": amount,
+	})
+
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.ERC20.Pack("approve", client.config.StakeManager, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(client.config.MaticToken, data, big.NewInt(0)).Build(ctx, client.pos.Root)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = client.pos.Root.SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	client.Logger().Debug("ApproveStake", log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
+
+func (client *Client) Epoch(ctx context.Context) (*big.Int, error) {
+	epochResp, err := utils.CallContract(ctx, client.pos.Root, client.config.StakeManager, maticabi.StakeManager,
+		"epoch",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return epochResp[0].(*big.Int), nil
+}
+
+func (client *Client) WithdrawalDelay(ctx context.Context) (*big.Int, error) {
+	withdrawalDelayResp, err := utils.CallContract(ctx, client.pos.Root, client.config.StakeManager, maticabi.StakeManager,
+		"withdrawalDelay",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return withdrawalDelayResp[0].(*big.Int), nil
+}
+
+func (client *Client) ValidatorSetSize(ctx context.Context) (*big.Int, error) {
+	currentValidatorSetSizeResp, err := utils.CallContract(ctx, client.pos.Root, client.config.StakeManager, maticabi.StakeManager,
+		"currentValidatorSetSize",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return currentValidatorSetSizeResp[0].(*big.Int), nil
+}
+
+func (client *Client) Validator(ctx context.Context, validatorId *big.Int) (types.Validator, error) {
+	client.Logger().Debug("Validator", log.Fields{
+		"validatorId": validatorId,
+	})
+
+	validatorsResp, err := utils.CallContract(ctx, client.pos.Root, client.config.StakeManager, maticabi.StakeManager,
+		"validators",
+		validatorId,
+	)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	return types.Validator{
+		ValidatorId:          validatorId,
+		Amount:               validatorsResp[0].(*big.Int),
+		Reward:               validatorsResp[1].(*big.Int),
+		ActivationEpoch:      validatorsResp[2].(*big.Int),
+		DeactivationEpoch:    validatorsResp[3].(*big.Int),
+		JailTime:             validatorsResp[4].(*big.Int),
+		Signer:               validatorsResp[5].(common.Address),
+		ContractAddress:      validatorsResp[6].(common.Address),
+		Status:               types.ValidatorStatus(validatorsResp[7].(uint8)),
+		CommissionRate:       validatorsResp[8].(*big.Int),
+		LastCommissionUpdate: validatorsResp[9].(*big.Int),
+		DelegatorsReward:     validatorsResp[10].(*big.Int),
+		DelegatedAmount:      validatorsResp[11].(*big.Int),
+	}, nil
+}
+
+// Validators : every validator ever staked, ids start at 1 and end before NFTCounter
+func (client *Client) Validators(ctx context.Context) ([]types.Validator, error) {
+	nftCounterResp, err := utils.CallContract(ctx, client.pos.Root, client.config.StakeManager, maticabi.StakeManager,
+		"NFTCounter",
+	)
+	if err != nil {
+		return nil, err
+	}
+	nftCounter := nftCounterResp[0].(*big.Int)
+
+	var validators []types.Validator
+	for validatorId := big.NewInt(1); validatorId.Cmp(nftCounter) == -1; validatorId = new(big.Int).Add(validatorId, big.NewInt(1)) {
+		validator, err := client.Validator(ctx, validatorId)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, validator)
+	}
+
+	return validators, nil
+}
+
+// ValidatorOwner : owner of the staking nft of validator
+func (client *Client) ValidatorOwner(ctx context.Context, validatorId *big.Int) (common.Address, error) {
+	ownerOfResp, err := utils.CallContract(ctx, client.pos.Root, client.config.StakingNFT, maticabi.ERC721,
+		"ownerOf",
+		validatorId,
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return ownerOfResp[0].(common.Address), nil
+}
+
+// ValidatorShare : delegation handle of validator, resolved from StakeManager.getValidatorContract
+func (client *Client) ValidatorShare(ctx context.Context, validatorId *big.Int) (*ValidatorShare, error) {
+	getValidatorContractResp, err := utils.CallContract(ctx, client.pos.Root, client.config.StakeManager, maticabi.StakeManager,
+		"getValidatorContract",
+		validatorId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	address := getValidatorContractResp[0].(common.Address)
+	if address == (common.Address{}) {
+		return nil, fmt.Errorf("validator share not found: %s", validatorId)
+	}
+
+	return newValidatorShare(client, validatorId, address), nil
+}
+
+// IsUnbondClaimable : unbond can be claimed with UnstakeClaimTokens once withdrawal delay has passed
+func (client *Client) IsUnbondClaimable(ctx context.Context, unbond types.Unbond) (bool, error) {
+	epoch, err := client.Epoch(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	withdrawalDelay, err := client.WithdrawalDelay(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	claimableEpoch := new(big.Int).Add(unbond.WithdrawEpoch, withdrawalDelay)
+	return unbond.Shares.Sign() == 1 && epoch.Cmp(claimableEpoch) >= 0, nil
+}
@@ -0,0 +1,62 @@
+package staking
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/pos"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+var (
+	TestPrivateKey, _ = crypto.HexToECDSA("1c28edecd1cdfbdb2e32c38d8e06ed042f3e31fb05d9884e5322376cce4706d4")
+	TestTxOption      = &types.TxOption{
+		PrivateKey: TestPrivateKey,
+		TxType:     types.DynamicFeeTxType,
+	}
+)
+
+func newTestClient(t *testing.T) *Client {
+	posClient, err := pos.NewClient(pos.NewDefaultConfig(types.TestNet))
+	require.NoError(t, err)
+
+	config, err := NewDefaultConfig(types.TestNet)
+	require.NoError(t, err)
+
+	client, err := NewClient(posClient, config)
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_Validator(t *testing.T) {
+	client := newTestClient(t)
+
+	validator, err := client.Validator(context.Background(), big.NewInt(1))
+	assert.NoError(t, err)
+	t.Log(validator.Signer, validator.Status, validator.CommissionRate)
+}
+
+func TestValidatorShare_Unbonds(t *testing.T) {
+	client := newTestClient(t)
+
+	share, err := client.ValidatorShare(context.Background(), big.NewInt(1))
+	require.NoError(t, err)
+
+	unbonds, err := share.Unbonds(context.Background(), TestTxOption.From())
+	assert.NoError(t, err)
+	t.Log(unbonds)
+}
+
+func TestValidatorShare_BuyVoucher(t *testing.T) {
+	client := newTestClient(t)
+
+	share, err := client.ValidatorShare(context.Background(), big.NewInt(1))
+	require.NoError(t, err)
+
+	hash, err := share.BuyVoucher(context.Background(), big.NewInt(1e18), big.NewInt(0), TestTxOption)
+	assert.NoError(t, err)
+	t.Log("txHash", hash.String())
+}
@@ -0,0 +1,187 @@
+package staking
+
+import (
+	"context"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type ValidatorShare struct {
+	client      *Client
+	logger      *types.Logger
+	validatorId *big.Int
+	address     common.Address
+}
+
+func newValidatorShare(client *Client, validatorId *big.Int, address common.Address) *ValidatorShare {
+	return &ValidatorShare{
+		client:      client,
+		validatorId: validatorId,
+		address:     address,
+		logger:      types.NewLogger("validator-share", client.pos.Config().Debug),
+	}
+}
+
+func (share *ValidatorShare) Logger() *types.Logger   { return share.logger }
+func (share *ValidatorShare) Address() common.Address { return share.address }
+func (share *ValidatorShare) ValidatorId() *big.Int   { return share.validatorId }
+
+// BuyVoucher : delegate amount of matic, matic must be approved to StakeManager
+func (share *ValidatorShare) BuyVoucher(ctx context.Context, amount, minSharesToMint *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	share.Logger().Debug("BuyVoucher", log.Fields{
+		"validatorId":     share.validatorId,
+		"amount":          amount,
+		"minSharesToMint": minSharesToMint,
+	})
+
+	return share.sendTransaction(ctx, "buyVoucher", txOption, amount, minSharesToMint)
+}
+
+// SellVoucher : undelegate claimAmount of matic, creates a new unbond with the next unbond nonce
+func (share *ValidatorShare) SellVoucher(ctx context.Context, claimAmount, maximumSharesToBurn *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	share.Logger().Debug("SellVoucher", log.Fields{
+		"validatorId":         share.validatorId,
+		"claimAmount":         claimAmount,
+		"maximumSharesToBurn": maximumSharesToBurn,
+	})
+
+	return share.sendTransaction(ctx, "sellVoucher_new", txOption, claimAmount, maximumSharesToBurn)
+}
+
+// UnstakeClaimTokens : claim matic of unbond after withdrawal delay
+func (share *ValidatorShare) UnstakeClaimTokens(ctx context.Context, unbondNonce *big.Int, txOption *types.TxOption) (common.Hash, error) {
+	share.Logger().Debug("UnstakeClaimTokens", log.Fields{
+		"validatorId": share.validatorId,
+		"unbondNonce": unbondNonce,
+	})
+
+	return share.sendTransaction(ctx, "unstakeClaimTokens_new", txOption, unbondNonce)
+}
+
+func (share *ValidatorShare) WithdrawRewards(ctx context.Context, txOption *types.TxOption) (common.Hash, error) {
+	share.Logger().Debug("WithdrawRewards", log.Fields{
+		"validatorId": share.validatorId,
+	})
+
+	return share.sendTransaction(ctx, "withdrawRewards", txOption)
+}
+
+func (share *ValidatorShare) Restake(ctx context.Context, txOption *types.TxOption) (common.Hash, error) {
+	share.Logger().Debug("Restake", log.Fields{
+		"validatorId": share.validatorId,
+	})
+
+	return share.sendTransaction(ctx, "restake", txOption)
+}
+
+// TotalStake : delegated matic and exchange rate of delegator
+func (share *ValidatorShare) TotalStake(ctx context.Context, delegator common.Address) (*big.Int, *big.Int, error) {
+	getTotalStakeResp, err := utils.CallContract(ctx, share.client.pos.Root, share.address, maticabi.ValidatorShare,
+		"getTotalStake",
+		delegator,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getTotalStakeResp[0].(*big.Int), getTotalStakeResp[1].(*big.Int), nil
+}
+
+func (share *ValidatorShare) LiquidRewards(ctx context.Context, delegator common.Address) (*big.Int, error) {
+	getLiquidRewardsResp, err := utils.CallContract(ctx, share.client.pos.Root, share.address, maticabi.ValidatorShare,
+		"getLiquidRewards",
+		delegator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return getLiquidRewardsResp[0].(*big.Int), nil
+}
+
+// UnbondNonce : latest unbond nonce of delegator, 0 when delegator never sold vouchers
+func (share *ValidatorShare) UnbondNonce(ctx context.Context, delegator common.Address) (*big.Int, error) {
+	unbondNoncesResp, err := utils.CallContract(ctx, share.client.pos.Root, share.address, maticabi.ValidatorShare,
+		"unbondNonces",
+		delegator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return unbondNoncesResp[0].(*big.Int), nil
+}
+
+func (share *ValidatorShare) Unbond(ctx context.Context, delegator common.Address, unbondNonce *big.Int) (types.Unbond, error) {
+	unbondsResp, err := utils.CallContract(ctx, share.client.pos.Root, share.address, maticabi.ValidatorShare,
+		"unbonds_new",
+		delegator,
+		unbondNonce,
+	)
+	if err != nil {
+		return types.Unbond{}, err
+	}
+
+	return types.Unbond{
+		Nonce:         unbondNonce,
+		Shares:        unbondsResp[0].(*big.Int),
+		WithdrawEpoch: unbondsResp[1].(*big.Int),
+	}, nil
+}
+
+// Unbonds : unclaimed unbonds of delegator, claimed unbonds are deleted on chain and skipped
+func (share *ValidatorShare) Unbonds(ctx context.Context, delegator common.Address) ([]types.Unbond, error) {
+	unbondNonce, err := share.UnbondNonce(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	var unbonds []types.Unbond
+	for nonce := big.NewInt(1); nonce.Cmp(unbondNonce) <= 0; nonce = new(big.Int).Add(nonce, big.NewInt(1)) {
+		unbond, err := share.Unbond(ctx, delegator, nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		if unbond.Shares.Sign() == 0 {
+			continue
+		}
+		unbonds = append(unbonds, unbond)
+	}
+
+	share.Logger().Debug("Unbonds", log.Fields{
+		"delegator": delegator,
+		"unbonds":   len(unbonds),
+	})
+	return unbonds, nil
+}
+
+func (share *ValidatorShare) sendTransaction(ctx context.Context, method string, txOption *types.TxOption, args ...interface{}) (common.Hash, error) {
+	if err := types.ValidateTxOption(txOption); err != nil {
+		return common.Hash{}, err
+	}
+
+	data, err := maticabi.ValidatorShare.Pack(method, args...)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := txOption.SetTxData(share.address, data, big.NewInt(0)).Build(ctx, share.client.pos.Root)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	err = share.client.pos.Root.SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	share.Logger().Debug(method, log.Fields{
+		"txHash": tx.Hash(),
+	})
+	return tx.Hash(), nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+type createAccessListResult struct {
+	AccessList *ether.AccessList `json:"accessList"`
+	GasUsed    hexutil.Uint64    `json:"gasUsed"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// CreateAccessList : eth_createAccessList for the calldata of SetTxData against the latest block
+func (txOption *TxOption) CreateAccessList(ctx context.Context, client IClient) (ether.AccessList, error) {
+	signer := txOption.signer()
+	if signer == nil {
+		return nil, EmptySigner
+	}
+
+	args := map[string]interface{}{
+		"from": signer.Address(),
+		"to":   txOption.to,
+		"data": hexutil.Bytes(txOption.data),
+	}
+	if txOption.value != nil {
+		args["value"] = (*hexutil.Big)(txOption.value)
+	}
+
+	var result createAccessListResult
+	if err := client.Rpc().CallContext(ctx, &result, "eth_createAccessList", args, "latest"); err != nil {
+		return nil, err
+	}
+
+	if result.Error != "" {
+		return nil, fmt.Errorf("create access list: %s", result.Error)
+	}
+
+	if result.AccessList == nil {
+		return ether.AccessList{}, nil
+	}
+
+	client.Logger().Debug("CreateAccessList", log.Fields{
+		"addresses":   len(*result.AccessList),
+		"storageKeys": result.AccessList.StorageKeys(),
+		"gasUsed":     uint64(result.GasUsed),
+	})
+	return *result.AccessList, nil
+}
@@ -0,0 +1,167 @@
+package types
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+)
+
+// DefaultCheckpointCache : in-memory cache shared by every root client of the process, header blocks are keyed by chain id and RootChain address
+var DefaultCheckpointCache = NewCheckpointCache()
+
+// CheckpointCache : index of submitted header blocks, reused across exits as checkpoints are immutable once submitted
+type CheckpointCache struct {
+	mu     sync.RWMutex
+	chains map[checkpointKey]*checkpointBlocks
+	file   *os.File
+}
+
+type checkpointKey struct {
+	chainId   string
+	rootChain common.Address
+}
+
+// checkpointBlocks : header blocks of one RootChain, by header block number and sorted by Start
+type checkpointBlocks struct {
+	byNumber map[string]RootBlockInfo
+	byStart  []RootBlockInfo
+}
+
+// checkpointEntry : line of the cache file
+type checkpointEntry struct {
+	ChainId   *big.Int       `json:"chainId"`
+	RootChain common.Address `json:"rootChain"`
+	RootBlockInfo
+}
+
+func NewCheckpointCache() *CheckpointCache {
+	return &CheckpointCache{
+		chains: make(map[checkpointKey]*checkpointBlocks),
+	}
+}
+
+func NewFileCheckpointCache(path string) (*CheckpointCache, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := NewCheckpointCache()
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var entry checkpointEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("checkpoint cache %s line %d: %w", path, line, err)
+		}
+		cache.put(entry.ChainId, entry.RootChain, entry.RootBlockInfo)
+	}
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	cache.file = file
+	return cache, nil
+}
+
+// Get : cached header block of rootChain on chainId
+func (cache *CheckpointCache) Get(chainId *big.Int, rootChain common.Address, headerBlockNumber *big.Int) (RootBlockInfo, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	blocks, ok := cache.chains[newCheckpointKey(chainId, rootChain)]
+	if !ok {
+		return RootBlockInfo{}, false
+	}
+	info, ok := blocks.byNumber[headerBlockNumber.String()]
+	return info, ok
+}
+
+// Find : cached header block of rootChain on chainId containing childBlockNumber
+func (cache *CheckpointCache) Find(chainId *big.Int, rootChain common.Address, childBlockNumber *big.Int) (RootBlockInfo, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	blocks, ok := cache.chains[newCheckpointKey(chainId, rootChain)]
+	if !ok {
+		return RootBlockInfo{}, false
+	}
+
+	// last header block starting at or before childBlockNumber
+	i := sort.Search(len(blocks.byStart), func(i int) bool {
+		return blocks.byStart[i].Start.Cmp(childBlockNumber) > 0
+	})
+	if i == 0 || !blocks.byStart[i-1].Contains(childBlockNumber) {
+		return RootBlockInfo{}, false
+	}
+	return blocks.byStart[i-1], true
+}
+
+// Put : cache a submitted header block of rootChain on chainId, header blocks without root are not submitted and ignored
+func (cache *CheckpointCache) Put(chainId *big.Int, rootChain common.Address, info RootBlockInfo) error {
+	if info.HeaderBlockNumber == nil || info.Start == nil || info.Root == (common.Hash{}) {
+		return nil
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if !cache.put(chainId, rootChain, info) || cache.file == nil {
+		return nil
+	}
+	line, err := json.Marshal(checkpointEntry{ChainId: chainId, RootChain: rootChain, RootBlockInfo: info})
+	if err != nil {
+		return err
+	}
+	_, err = cache.file.Write(append(line, '\n'))
+	return err
+}
+
+// Close : close the cache file, the cache stays usable in memory
+func (cache *CheckpointCache) Close() error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.file == nil {
+		return nil
+	}
+	err := cache.file.Close()
+	cache.file = nil
+	return err
+}
+
+// put : add info unless already cached, reports whether it was added
+func (cache *CheckpointCache) put(chainId *big.Int, rootChain common.Address, info RootBlockInfo) bool {
+	key := newCheckpointKey(chainId, rootChain)
+	blocks, ok := cache.chains[key]
+	if !ok {
+		blocks = &checkpointBlocks{byNumber: make(map[string]RootBlockInfo)}
+		cache.chains[key] = blocks
+	}
+	if _, ok := blocks.byNumber[info.HeaderBlockNumber.String()]; ok {
+		return false
+	}
+	blocks.byNumber[info.HeaderBlockNumber.String()] = info
+
+	i := sort.Search(len(blocks.byStart), func(i int) bool {
+		return blocks.byStart[i].Start.Cmp(info.Start) > 0
+	})
+	blocks.byStart = append(blocks.byStart, RootBlockInfo{})
+	copy(blocks.byStart[i+1:], blocks.byStart[i:])
+	blocks.byStart[i] = info
+	return true
+}
+
+func newCheckpointKey(chainId *big.Int, rootChain common.Address) checkpointKey {
+	return checkpointKey{chainId: chainId.String(), rootChain: rootChain}
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestRootBlockInfo(headerBlockNumber, start, end int64) RootBlockInfo {
+	return RootBlockInfo{
+		HeaderBlockNumber: big.NewInt(headerBlockNumber),
+		Start:             big.NewInt(start),
+		End:               big.NewInt(end),
+		Root:              common.BigToHash(big.NewInt(headerBlockNumber)),
+		Proposer:          common.HexToAddress("0x01"),
+		CreatedAt:         big.NewInt(1670000000),
+	}
+}
+
+func TestCheckpointCache(t *testing.T) {
+	cache := NewCheckpointCache()
+	chainId := big.NewInt(1)
+	rootChain := common.HexToAddress("0x02")
+
+	// out of order puts are kept sorted by start
+	assert.NoError(t, cache.Put(chainId, rootChain, newTestRootBlockInfo(30000, 512, 767)))
+	assert.NoError(t, cache.Put(chainId, rootChain, newTestRootBlockInfo(10000, 0, 255)))
+	assert.NoError(t, cache.Put(chainId, rootChain, newTestRootBlockInfo(20000, 256, 511)))
+
+	// header blocks not yet submitted are not cached
+	assert.NoError(t, cache.Put(chainId, rootChain, RootBlockInfo{
+		HeaderBlockNumber: big.NewInt(50000),
+		Start:             big.NewInt(0),
+		End:               big.NewInt(0),
+	}))
+	_, ok := cache.Get(chainId, rootChain, big.NewInt(50000))
+	assert.False(t, ok)
+
+	info, ok := cache.Get(chainId, rootChain, big.NewInt(20000))
+	assert.True(t, ok)
+	assert.Equal(t, newTestRootBlockInfo(20000, 256, 511), info)
+
+	for _, test := range []struct {
+		childBlockNumber  int64
+		headerBlockNumber int64
+	}{
+		{0, 10000},
+		{255, 10000},
+		{256, 20000},
+		{511, 20000},
+		{767, 30000},
+		{768, 0},
+	} {
+		info, ok = cache.Find(chainId, rootChain, big.NewInt(test.childBlockNumber))
+		if test.headerBlockNumber == 0 {
+			assert.False(t, ok)
+			continue
+		}
+		assert.True(t, ok)
+		assert.Equal(t, big.NewInt(test.headerBlockNumber), info.HeaderBlockNumber)
+	}
+
+	// header blocks past the cached ones leave a gap
+	assert.NoError(t, cache.Put(chainId, rootChain, newTestRootBlockInfo(60000, 1280, 1535)))
+	_, ok = cache.Find(chainId, rootChain, big.NewInt(1000))
+	assert.False(t, ok)
+
+	// other RootChain contracts and other chains sharing the address
+	_, ok = cache.Find(chainId, common.HexToAddress("0x03"), big.NewInt(256))
+	assert.False(t, ok)
+	_, ok = cache.Find(big.NewInt(5), rootChain, big.NewInt(256))
+	assert.False(t, ok)
+	_, ok = cache.Get(big.NewInt(5), rootChain, big.NewInt(20000))
+	assert.False(t, ok)
+}
+
+func TestFileCheckpointCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoints.jsonl")
+	chainId := big.NewInt(1)
+	rootChain := common.HexToAddress("0x02")
+
+	cache, err := NewFileCheckpointCache(path)
+	assert.NoError(t, err)
+	assert.NoError(t, cache.Put(chainId, rootChain, newTestRootBlockInfo(10000, 0, 255)))
+	assert.NoError(t, cache.Put(chainId, rootChain, newTestRootBlockInfo(20000, 256, 511)))
+	assert.NoError(t, cache.Put(chainId, rootChain, newTestRootBlockInfo(20000, 256, 511)))
+	assert.NoError(t, cache.Close())
+
+	// same RootChain address on another chain
+	cache, err = NewFileCheckpointCache(path)
+	assert.NoError(t, err)
+	assert.NoError(t, cache.Put(big.NewInt(5), rootChain, newTestRootBlockInfo(20000, 0, 1023)))
+	assert.NoError(t, cache.Close())
+
+	cache, err = NewFileCheckpointCache(path)
+	assert.NoError(t, err)
+	defer cache.Close()
+
+	info, ok := cache.Find(chainId, rootChain, big.NewInt(300))
+	assert.True(t, ok)
+	assert.Equal(t, newTestRootBlockInfo(20000, 256, 511), info)
+
+	_, ok = cache.Get(chainId, rootChain, big.NewInt(10000))
+	assert.True(t, ok)
+
+	info, ok = cache.Find(big.NewInt(5), rootChain, big.NewInt(300))
+	assert.True(t, ok)
+	assert.Equal(t, newTestRootBlockInfo(20000, 0, 1023), info)
+
+	// corrupt file
+	assert.NoError(t, os.WriteFile(path, []byte("{\n"), 0600))
+	_, err = NewFileCheckpointCache(path)
+	assert.ErrorContains(t, err, "line 1")
+}
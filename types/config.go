@@ -1,17 +1,50 @@
 package types
 
 import (
+	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
+	"math/big"
+	"strconv"
+	"strings"
 )
 
 type Network int
 
+// Network : root chain id of a pos network, custom networks are added with utils.RegisterNetwork
 const (
 	MainNet = Network(1)
+	// TestNet : goerli and mumbai, both deprecated
 	TestNet = Network(5)
+	// Amoy : sepolia and amoy
+	Amoy = Network(11155111)
 )
 
+// networkNames : names of the built-in networks in config files and environment variables
+var networkNames = map[string]Network{
+	"mainnet": MainNet,
+	"testnet": TestNet,
+	"amoy":    Amoy,
+}
+
+// UnmarshalText : network name, mainnet, testnet or amoy, or root chain id of a custom network
+func (network *Network) UnmarshalText(text []byte) error {
+	if named, ok := networkNames[strings.ToLower(string(text))]; ok {
+		*network = named
+		return nil
+	}
+
+	chainId, err := strconv.ParseUint(string(text), 10, 32)
+	if err != nil {
+		return fmt.Errorf("unknown network %q", text)
+	}
+	*network = Network(chainId)
+	return nil
+}
+
+// DefaultCheckpointInterval : header block ids of RootChain advance by MAX_DEPOSITS per checkpoint
+const DefaultCheckpointInterval = 10000
+
 type NetworkType int
 
 const (
@@ -20,22 +53,267 @@ const (
 )
 
 type POSClientConfig struct {
-	Child ChildConfig
-	Root  RootConfig
-	Debug DebugConfig
+	Child ChildConfig `json:"child" yaml:"child"`
+	Root  RootConfig  `json:"root" yaml:"root"`
+	Debug DebugConfig `json:"debug" yaml:"debug"`
+
+	// Gas : default gas policy of transactions, see GasConfig.TxOption
+	Gas GasConfig `json:"gas" yaml:"gas"`
+	// Signer : signer reference, resolved with signer.FromConfig
+	Signer SignerConfig `json:"signer" yaml:"signer"`
+}
+
+// ConfigError : config field which failed validation
+type ConfigError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("invalid config %s: %s", e.Field, e.Reason)
+}
+
+// Validate : rpc endpoints and contract addresses required by the pos client are set, gas policy and signer are consistent
+func (config POSClientConfig) Validate() error {
+	if config.Root.Rpc == "" {
+		return &ConfigError{Field: "Root.Rpc", Reason: "empty rpc endpoint"}
+	}
+	if config.Child.Rpc == "" {
+		return &ConfigError{Field: "Child.Rpc", Reason: "empty rpc endpoint"}
+	}
+	for _, endpoints := range []struct {
+		field     string
+		endpoints []string
+	}{
+		{"Root.Fallbacks", config.Root.Endpoints()},
+		{"Child.Fallbacks", config.Child.Endpoints()},
+	} {
+		if err := validateFallbacks(endpoints.field, endpoints.endpoints); err != nil {
+			return err
+		}
+	}
+
+	if err := validateAddresses([]configAddress{
+		{"Root.RootChain", config.Root.RootChain},
+		{"Root.RootChainManager", config.Root.RootChainManager},
+		{"Root.StateSender", config.Root.StateSender},
+		{"Child.StateReceiver", config.Child.StateReceiver},
+	}); err != nil {
+		return err
+	}
+
+	if err := config.Gas.Validate(); err != nil {
+		return err
+	}
+	return config.Signer.Validate()
+}
+
+// configAddress : contract address of a config field
+type configAddress struct {
+	field   string
+	address common.Address
+}
+
+// validateAddresses : ConfigError of the first zero address
+func validateAddresses(addresses []configAddress) error {
+	for _, address := range addresses {
+		if address.address == (common.Address{}) {
+			return &ConfigError{Field: address.field, Reason: "zero address"}
+		}
+	}
+	return nil
+}
+
+// validateFallbacks : endpoints of a failover pool are non-empty http urls
+func validateFallbacks(field string, endpoints []string) error {
+	if len(endpoints) == 1 {
+		return nil
+	}
+	for _, endpoint := range endpoints {
+		if endpoint == "" {
+			return &ConfigError{Field: field, Reason: "empty rpc endpoint"}
+		}
+		if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+			return &ConfigError{Field: field, Reason: fmt.Sprintf("%s is not http, failover supports http and https only", endpoint)}
+		}
+	}
+	return nil
 }
 
 type ChildConfig struct {
-	Rpc string
+	Rpc           string         `json:"rpc" yaml:"rpc"`
+	StateReceiver common.Address `json:"stateReceiver" yaml:"stateReceiver"`
+
+	// Fallbacks : endpoints tried in order when Rpc fails, http and https only
+	Fallbacks []string `json:"fallbacks" yaml:"fallbacks"`
+
+	// RootHash : source of block range root hashes for block proofs, RootHashAuto when zero
+	RootHash RootHashSource `json:"rootHash" yaml:"rootHash"`
+}
+
+// Endpoints : Rpc followed by Fallbacks
+func (config ChildConfig) Endpoints() []string {
+	return append([]string{config.Rpc}, config.Fallbacks...)
+}
+
+// RootHashSource : how the root hash of a child block range is obtained
+type RootHashSource int
+
+const (
+	// RootHashAuto : bor eth_getRootHash, computed locally when the node does not serve it
+	RootHashAuto = RootHashSource(0)
+	// RootHashRPC : bor eth_getRootHash only
+	RootHashRPC = RootHashSource(1)
+	// RootHashLocal : merkle root of the block headers, fetched in batches
+	RootHashLocal = RootHashSource(2)
+)
+
+// rootHashSourceNames : names of RootHashSource in config files and environment variables
+var rootHashSourceNames = map[RootHashSource]string{
+	RootHashAuto:  "auto",
+	RootHashRPC:   "rpc",
+	RootHashLocal: "local",
+}
+
+func (source RootHashSource) MarshalText() ([]byte, error) {
+	name, ok := rootHashSourceNames[source]
+	if !ok {
+		return nil, fmt.Errorf("unknown root hash source %d", source)
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText : auto, rpc or local
+func (source *RootHashSource) UnmarshalText(text []byte) error {
+	for value, name := range rootHashSourceNames {
+		if strings.EqualFold(name, string(text)) {
+			*source = value
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown root hash source %q, expected auto, rpc or local", text)
 }
 
 type RootConfig struct {
-	Rpc              string
-	RootChain        common.Address
-	RootChainManager common.Address
+	Rpc              string         `json:"rpc" yaml:"rpc"`
+	RootChain        common.Address `json:"rootChain" yaml:"rootChain"`
+	RootChainManager common.Address `json:"rootChainManager" yaml:"rootChainManager"`
+	StateSender      common.Address `json:"stateSender" yaml:"stateSender"`
+
+	// Fallbacks : endpoints tried in order when Rpc fails, http and https only
+	Fallbacks []string `json:"fallbacks" yaml:"fallbacks"`
+	// CheckpointInterval : header block id step of RootChain, DefaultCheckpointInterval when zero
+	CheckpointInterval uint64 `json:"checkpointInterval" yaml:"checkpointInterval"`
+}
+
+// Endpoints : Rpc followed by Fallbacks
+func (config RootConfig) Endpoints() []string {
+	return append([]string{config.Rpc}, config.Fallbacks...)
+}
+
+// GetCheckpointInterval : CheckpointInterval or DefaultCheckpointInterval
+func (config RootConfig) GetCheckpointInterval() *big.Int {
+	if config.CheckpointInterval == 0 {
+		return new(big.Int).SetUint64(DefaultCheckpointInterval)
+	}
+	return new(big.Int).SetUint64(config.CheckpointInterval)
+}
+
+type FxPortalConfig struct {
+	FxRoot  common.Address
+	FxChild common.Address
+
+	ERC20           FxTunnelConfig
+	ERC721          FxTunnelConfig
+	ERC1155         FxTunnelConfig
+	MintableERC20   FxTunnelConfig
+	MintableERC721  FxTunnelConfig
+	MintableERC1155 FxTunnelConfig
+}
+
+// Validate : fx root and fx child are set, a tunnel is either fully set or left zero when it is not deployed
+func (config FxPortalConfig) Validate() error {
+	if err := validateAddresses([]configAddress{
+		{"FxRoot", config.FxRoot},
+		{"FxChild", config.FxChild},
+	}); err != nil {
+		return err
+	}
+
+	for _, tunnel := range []struct {
+		field  string
+		tunnel FxTunnelConfig
+	}{
+		{"ERC20", config.ERC20},
+		{"ERC721", config.ERC721},
+		{"ERC1155", config.ERC1155},
+		{"MintableERC20", config.MintableERC20},
+		{"MintableERC721", config.MintableERC721},
+		{"MintableERC1155", config.MintableERC1155},
+	} {
+		if tunnel.tunnel.IsZero() {
+			continue
+		}
+		if err := validateAddresses([]configAddress{
+			{tunnel.field + ".RootTunnel", tunnel.tunnel.RootTunnel},
+			{tunnel.field + ".ChildTunnel", tunnel.tunnel.ChildTunnel},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type FxTunnelConfig struct {
+	RootTunnel  common.Address
+	ChildTunnel common.Address
+}
+
+// IsZero : tunnel is not deployed on the network
+func (config FxTunnelConfig) IsZero() bool {
+	return config.RootTunnel == (common.Address{}) && config.ChildTunnel == (common.Address{})
+}
+
+type PlasmaConfig struct {
+	DepositManager  common.Address
+	WithdrawManager common.Address
+	ERC20Predicate  common.Address
+	ExitNFT         common.Address
+	MaticToken      common.Address
+}
+
+// Validate : contract addresses required by the plasma client are set
+func (config PlasmaConfig) Validate() error {
+	return validateAddresses([]configAddress{
+		{"DepositManager", config.DepositManager},
+		{"WithdrawManager", config.WithdrawManager},
+		{"ERC20Predicate", config.ERC20Predicate},
+		{"ExitNFT", config.ExitNFT},
+		{"MaticToken", config.MaticToken},
+	})
+}
+
+type StakingConfig struct {
+	StakeManager common.Address
+	StakingNFT   common.Address
+	MaticToken   common.Address
+}
+
+// Validate : contract addresses required by the staking client are set
+func (config StakingConfig) Validate() error {
+	return validateAddresses([]configAddress{
+		{"StakeManager", config.StakeManager},
+		{"StakingNFT", config.StakingNFT},
+		{"MaticToken", config.MaticToken},
+	})
+}
+
+type HeimdallConfig struct {
+	API   string
+	Debug DebugConfig
 }
 
 type DebugConfig struct {
-	Enable bool
-	Level  log.Level
+	Enable bool      `json:"enable" yaml:"enable"`
+	Level  log.Level `json:"level" yaml:"level"`
 }
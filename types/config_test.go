@@ -0,0 +1,58 @@
+package types
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestClientConfig_Validate(t *testing.T) {
+	tunnel := FxTunnelConfig{RootTunnel: common.HexToAddress("0x11"), ChildTunnel: common.HexToAddress("0x12")}
+	fxConfig := FxPortalConfig{
+		FxRoot:  common.HexToAddress("0x01"),
+		FxChild: common.HexToAddress("0x02"),
+		ERC20:   tunnel,
+	}
+	plasmaConfig := PlasmaConfig{
+		DepositManager:  common.HexToAddress("0x01"),
+		WithdrawManager: common.HexToAddress("0x02"),
+		ERC20Predicate:  common.HexToAddress("0x03"),
+		ExitNFT:         common.HexToAddress("0x04"),
+		MaticToken:      common.HexToAddress("0x05"),
+	}
+	stakingConfig := StakingConfig{
+		StakeManager: common.HexToAddress("0x01"),
+		StakingNFT:   common.HexToAddress("0x02"),
+		MaticToken:   common.HexToAddress("0x03"),
+	}
+
+	for _, test := range []struct {
+		name   string
+		config interface{ Validate() error }
+		field  string
+	}{
+		{"fx", fxConfig, ""},
+		{"fx without fx child", func() FxPortalConfig { config := fxConfig; config.FxChild = common.Address{}; return config }(), "FxChild"},
+		{"fx half tunnel", func() FxPortalConfig { config := fxConfig; config.ERC721.RootTunnel = tunnel.RootTunnel; return config }(), "ERC721.ChildTunnel"},
+		{"plasma", plasmaConfig, ""},
+		{"plasma without exit nft", func() PlasmaConfig { config := plasmaConfig; config.ExitNFT = common.Address{}; return config }(), "ExitNFT"},
+		{"plasma without predicate", func() PlasmaConfig { config := plasmaConfig; config.ERC20Predicate = common.Address{}; return config }(), "ERC20Predicate"},
+		{"staking", stakingConfig, ""},
+		{"staking without staking nft", func() StakingConfig { config := stakingConfig; config.StakingNFT = common.Address{}; return config }(), "StakingNFT"},
+		{"staking empty", StakingConfig{}, "StakeManager"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var configErr *ConfigError
+			if assert.True(t, errors.As(err, &configErr), "%v", err) {
+				assert.Equal(t, test.field, configErr.Field)
+			}
+		})
+	}
+}
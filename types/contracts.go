@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -8,12 +9,16 @@ const MaticAddress = "0x0000000000000000000000000000000000001010"
 
 const TestNetContractURL = `https://static.matic.network/network/testnet/mumbai/index.json`
 const MainNetContractURL = `https://static.matic.network/network/mainnet/v1/index.json`
-
+const AmoyContractURL = `https://static.polygon.technology/network/testnet/amoy/index.json`
 
 type Contract struct {
+	// Version : version of the embedded registry, empty for remote registries
+	Version string `json:"Version"`
+
 	Main struct {
 		NetworkName       string                `json:"NetworkName"`
 		ChainID           int                   `json:"ChainId"`
+		RPC               string                `json:"RPC"`
 		DaggerEndpoint    string                `json:"DaggerEndpoint"`
 		WatcherAPI        string                `json:"WatcherAPI"`
 		StakingAPI        string                `json:"StakingAPI"`
@@ -44,17 +49,93 @@ type Contract struct {
 	} `json:"Heimdall"`
 }
 
+// Validate : addresses required by the pos client are set
+func (c Contract) Validate() error {
+	for _, address := range []struct {
+		field   string
+		address string
+	}{
+		{"Main.Contracts.RootChainProxy", c.Main.Contracts.RootChainProxy},
+		{"Main.Contracts.StateSender", c.Main.Contracts.StateSender},
+		{"Main.POSContracts.RootChainManagerProxy", c.Main.POSContracts.RootChainManagerProxy},
+		{"Matic.GenesisContracts.StateReceiver", c.Matic.GenesisContracts.StateReceiver},
+	} {
+		if !common.IsHexAddress(address.address) || common.HexToAddress(address.address) == (common.Address{}) {
+			return &ConfigError{Field: address.field, Reason: fmt.Sprintf("invalid address %q", address.address)}
+		}
+	}
+	return nil
+}
+
 func (c Contract) RootConfig(rpc string) RootConfig {
 	return RootConfig{
 		Rpc:              rpc,
 		RootChain:        common.HexToAddress(c.Main.Contracts.RootChainProxy),
 		RootChainManager: common.HexToAddress(c.Main.POSContracts.RootChainManagerProxy),
+		StateSender:      common.HexToAddress(c.Main.Contracts.StateSender),
 	}
 }
 
 func (c Contract) ChildConfig(rpc string) ChildConfig {
 	return ChildConfig{
-		Rpc: rpc,
+		Rpc:           rpc,
+		StateReceiver: common.HexToAddress(c.Matic.GenesisContracts.StateReceiver),
+	}
+}
+
+func (c Contract) FxPortalConfig() FxPortalConfig {
+	return FxPortalConfig{
+		FxRoot:  common.HexToAddress(c.Main.FxPortalContracts.FxRoot),
+		FxChild: common.HexToAddress(c.Matic.FxPortalContracts.FxChild),
+		ERC20: FxTunnelConfig{
+			RootTunnel:  common.HexToAddress(c.Main.FxPortalContracts.FxERC20RootTunnel),
+			ChildTunnel: common.HexToAddress(c.Matic.FxPortalContracts.FxERC20ChildTunnel),
+		},
+		ERC721: FxTunnelConfig{
+			RootTunnel:  common.HexToAddress(c.Main.FxPortalContracts.FxERC721RootTunnel),
+			ChildTunnel: common.HexToAddress(c.Matic.FxPortalContracts.FxERC721ChildTunnel),
+		},
+		ERC1155: FxTunnelConfig{
+			RootTunnel:  common.HexToAddress(c.Main.FxPortalContracts.FxERC1155RootTunnel),
+			ChildTunnel: common.HexToAddress(c.Matic.FxPortalContracts.FxERC1155ChildTunnel),
+		},
+		MintableERC20: FxTunnelConfig{
+			RootTunnel:  common.HexToAddress(c.Main.FxPortalContracts.FxMintableERC20RootTunnel),
+			ChildTunnel: common.HexToAddress(c.Matic.FxPortalContracts.FxMintableERC20ChildTunnel),
+		},
+		MintableERC721: FxTunnelConfig{
+			RootTunnel:  common.HexToAddress(c.Main.FxPortalContracts.FxMintableERC721RootTunnel),
+			ChildTunnel: common.HexToAddress(c.Matic.FxPortalContracts.FxMintableERC721ChildTunnel),
+		},
+		MintableERC1155: FxTunnelConfig{
+			RootTunnel:  common.HexToAddress(c.Main.FxPortalContracts.FxMintableERC1555RootTunnel),
+			ChildTunnel: common.HexToAddress(c.Matic.FxPortalContracts.FxMintableERC1155ChildTunnel),
+		},
+	}
+}
+
+func (c Contract) PlasmaConfig() PlasmaConfig {
+	return PlasmaConfig{
+		DepositManager:  common.HexToAddress(c.Main.Contracts.DepositManagerProxy),
+		WithdrawManager: common.HexToAddress(c.Main.Contracts.WithdrawManagerProxy),
+		ERC20Predicate:  common.HexToAddress(c.Main.Contracts.ERC20Predicate),
+		ExitNFT:         common.HexToAddress(c.Main.Contracts.ExitNFT),
+		MaticToken:      common.HexToAddress(c.Main.Contracts.Tokens.MaticToken),
+	}
+}
+
+func (c Contract) StakingConfig() StakingConfig {
+	return StakingConfig{
+		StakeManager: common.HexToAddress(c.Main.Contracts.StakeManagerProxy),
+		StakingNFT:   common.HexToAddress(c.Main.Contracts.StakingNFT),
+		MaticToken:   common.HexToAddress(c.Main.Contracts.Tokens.MaticToken),
+	}
+}
+
+func (c Contract) HeimdallConfig(debug DebugConfig) HeimdallConfig {
+	return HeimdallConfig{
+		API:   c.Heimdall.API,
+		Debug: debug,
 	}
 }
 
@@ -0,0 +1,434 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEndpointTimeout : timeout of one attempt on one endpoint before failing over
+	DefaultEndpointTimeout = 10 * time.Second
+	// DefaultEndpointCooldown : time a failed endpoint is skipped while others are healthy
+	DefaultEndpointCooldown = 30 * time.Second
+	// DefaultMaxBlockLag : blocks an endpoint may trail the highest head before CheckHealth marks it down
+	DefaultMaxBlockLag = 10
+)
+
+// EndpointPool : http transport of json-rpc requests over several endpoints of one chain.
+// Requests go to the first healthy endpoint in order and fail over on transport errors, timeouts and 429/5xx,
+// eth_sendRawTransaction is broadcast to every endpoint.
+type EndpointPool struct {
+	endpoints []*endpoint
+	transport http.RoundTripper
+	logger    *Logger
+
+	timeout     time.Duration
+	cooldown    time.Duration
+	maxBlockLag uint64
+}
+
+// healthRequest : request of CheckHealth
+var healthRequest = []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+
+type endpoint struct {
+	url *url.URL
+
+	mu        sync.Mutex
+	downUntil time.Time
+	head      uint64
+	lastError error
+}
+
+// EndpointStatus : health of an endpoint as last seen by the pool
+type EndpointStatus struct {
+	URL       string
+	Healthy   bool
+	Head      uint64
+	LastError error
+}
+
+// NewEndpointPool : pool of http(s) endpoints, urls[0] preferred
+func NewEndpointPool(urls []string, logger *Logger) (*EndpointPool, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("endpoint pool without endpoints")
+	}
+
+	pool := &EndpointPool{
+		transport:   http.DefaultTransport,
+		logger:      logger,
+		timeout:     DefaultEndpointTimeout,
+		cooldown:    DefaultEndpointCooldown,
+		maxBlockLag: DefaultMaxBlockLag,
+	}
+	for _, rawURL := range urls {
+		endpointURL, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+			return nil, fmt.Errorf("endpoint %s: failover supports http and https endpoints only", rawURL)
+		}
+		pool.endpoints = append(pool.endpoints, &endpoint{url: endpointURL})
+	}
+
+	return pool, nil
+}
+
+// WithTimeout : timeout of one attempt on one endpoint
+func (pool *EndpointPool) WithTimeout(timeout time.Duration) *EndpointPool {
+	pool.timeout = timeout
+	return pool
+}
+
+// WithCooldown : time a failed endpoint is skipped
+func (pool *EndpointPool) WithCooldown(cooldown time.Duration) *EndpointPool {
+	pool.cooldown = cooldown
+	return pool
+}
+
+// WithMaxBlockLag : blocks an endpoint may trail the highest head of the pool
+func (pool *EndpointPool) WithMaxBlockLag(maxBlockLag uint64) *EndpointPool {
+	pool.maxBlockLag = maxBlockLag
+	return pool
+}
+
+// WithTransport : transport of the requests to each endpoint, http.DefaultTransport by default
+func (pool *EndpointPool) WithTransport(transport http.RoundTripper) *EndpointPool {
+	pool.transport = transport
+	return pool
+}
+
+// Dial : rpc client sending every request through the pool
+func (pool *EndpointPool) Dial() (*rpc.Client, error) {
+	return rpc.DialHTTPWithClient(pool.endpoints[0].url.String(), &http.Client{Transport: pool})
+}
+
+// Status : endpoints in order with their last seen health
+func (pool *EndpointPool) Status() []EndpointStatus {
+	now := time.Now()
+	status := make([]EndpointStatus, 0, len(pool.endpoints))
+	for _, endpoint := range pool.endpoints {
+		endpoint.mu.Lock()
+		status = append(status, EndpointStatus{
+			URL:       endpoint.url.String(),
+			Healthy:   !now.Before(endpoint.downUntil),
+			Head:      endpoint.head,
+			LastError: endpoint.lastError,
+		})
+		endpoint.mu.Unlock()
+	}
+	return status
+}
+
+// CheckHealth : query the head of every endpoint, endpoints failing or trailing the highest head by more than
+// the max block lag are skipped for the cooldown. Error when no endpoint is healthy.
+func (pool *EndpointPool) CheckHealth(ctx context.Context) error {
+	heads := make([]uint64, len(pool.endpoints))
+	errs := make([]error, len(pool.endpoints))
+
+	var wg sync.WaitGroup
+	for i := range pool.endpoints {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			heads[i], errs[i] = pool.blockNumber(ctx, pool.endpoints[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var best uint64
+	for i, head := range heads {
+		if errs[i] == nil && head > best {
+			best = head
+		}
+	}
+
+	healthy := 0
+	for i, endpoint := range pool.endpoints {
+		switch {
+		case errs[i] != nil:
+			pool.markDown(endpoint, errs[i])
+		case heads[i]+pool.maxBlockLag < best:
+			pool.markDown(endpoint, fmt.Errorf("head %d trails %d", heads[i], best))
+		default:
+			endpoint.mu.Lock()
+			endpoint.downUntil = time.Time{}
+			endpoint.head = heads[i]
+			endpoint.lastError = nil
+			endpoint.mu.Unlock()
+			healthy++
+		}
+	}
+
+	if healthy == 0 {
+		return fmt.Errorf("no healthy endpoint of %d", len(pool.endpoints))
+	}
+	return nil
+}
+
+// WatchHealth : CheckHealth every interval until ctx is done
+func (pool *EndpointPool) WatchHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := pool.CheckHealth(ctx); err != nil {
+			pool.logger.Error("CheckHealth", log.Fields{
+				"error": err.Error(),
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RoundTrip : http.RoundTripper of the rpc client
+func (pool *EndpointPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if isSendRawTransaction(body) {
+		return pool.broadcast(req, body)
+	}
+
+	session := stickySessionFrom(req.Context())
+	var firstErr error
+	for _, endpoint := range pool.order(session) {
+		resp, err := pool.send(req, endpoint, body)
+		if err == nil {
+			session.pin(pool, endpoint)
+			return resp, nil
+		}
+
+		pool.markDown(endpoint, err)
+		if firstErr == nil {
+			firstErr = err
+		}
+		if req.Context().Err() != nil {
+			break
+		}
+	}
+	return nil, firstErr
+}
+
+// broadcast : send the transaction to every endpoint, the first accepting response wins
+func (pool *EndpointPool) broadcast(req *http.Request, body []byte) (*http.Response, error) {
+	type result struct {
+		resp *http.Response
+		err  error
+	}
+	results := make([]result, len(pool.endpoints))
+
+	var wg sync.WaitGroup
+	for i, target := range pool.endpoints {
+		wg.Add(1)
+		go func(i int, target *endpoint) {
+			defer wg.Done()
+			resp, err := pool.send(req, target, body)
+			if err != nil {
+				pool.markDown(target, err)
+			}
+			results[i] = result{resp, err}
+		}(i, target)
+	}
+	wg.Wait()
+
+	// results in endpoint order, an accepted tx first, then any rpc error, then the first transport error
+	var rejected, failed *result
+	for i := range results {
+		switch {
+		case results[i].err != nil:
+			if failed == nil {
+				failed = &results[i]
+			}
+		case isAccepted(results[i].resp):
+			return results[i].resp, nil
+		case rejected == nil:
+			rejected = &results[i]
+		}
+	}
+
+	if rejected != nil {
+		return rejected.resp, nil
+	}
+	return failed.resp, failed.err
+}
+
+// send : request of req to endpoint, response body read in full within the timeout
+func (pool *EndpointPool) send(req *http.Request, endpoint *endpoint, body []byte) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), pool.timeout)
+	defer cancel()
+
+	endpointReq := req.Clone(ctx)
+	endpointReq.URL = endpoint.url
+	endpointReq.Host = ""
+	endpointReq.Body = io.NopCloser(bytes.NewReader(body))
+	endpointReq.ContentLength = int64(len(body))
+
+	resp, err := pool.transport.RoundTrip(endpointReq)
+	if err != nil {
+		return nil, fmt.Errorf("endpoint %s: %w", endpoint.url.Redacted(), err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("endpoint %s: %w", endpoint.url.Redacted(), err)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("endpoint %s: %s", endpoint.url.Redacted(), resp.Status)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return resp, nil
+}
+
+// order : pinned endpoint of session first, then healthy endpoints, then the ones cooling down
+func (pool *EndpointPool) order(session *stickySession) []*endpoint {
+	now := time.Now()
+	order := make([]*endpoint, 0, len(pool.endpoints))
+
+	pinned := session.pinned(pool)
+	if pinned != nil {
+		order = append(order, pinned)
+	}
+
+	var down []*endpoint
+	for _, endpoint := range pool.endpoints {
+		if endpoint == pinned {
+			continue
+		}
+		endpoint.mu.Lock()
+		healthy := !now.Before(endpoint.downUntil)
+		endpoint.mu.Unlock()
+
+		if healthy {
+			order = append(order, endpoint)
+		} else {
+			down = append(down, endpoint)
+		}
+	}
+	return append(order, down...)
+}
+
+func (pool *EndpointPool) markDown(endpoint *endpoint, err error) {
+	endpoint.mu.Lock()
+	endpoint.downUntil = time.Now().Add(pool.cooldown)
+	endpoint.lastError = err
+	endpoint.mu.Unlock()
+
+	pool.logger.Debug("EndpointPool", log.Fields{
+		"endpoint": endpoint.url.Redacted(),
+		"error":    err.Error(),
+	})
+}
+
+func (pool *EndpointPool) blockNumber(ctx context.Context, endpoint *endpoint) (uint64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.url.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := pool.send(req, endpoint, healthRequest)
+	if err != nil {
+		return 0, err
+	}
+
+	var msg struct {
+		Result *hexutil.Uint64 `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&msg); err != nil {
+		return 0, fmt.Errorf("endpoint %s: %w", endpoint.url.Redacted(), err)
+	}
+	if msg.Error != nil {
+		return 0, fmt.Errorf("endpoint %s: %s", endpoint.url.Redacted(), msg.Error.Message)
+	}
+	if msg.Result == nil {
+		return 0, fmt.Errorf("endpoint %s: empty eth_blockNumber", endpoint.url.Redacted())
+	}
+	return uint64(*msg.Result), nil
+}
+
+func isSendRawTransaction(body []byte) bool {
+	var msg struct {
+		Method string `json:"method"`
+	}
+	return json.Unmarshal(body, &msg) == nil && msg.Method == "eth_sendRawTransaction"
+}
+
+// isAccepted : json-rpc response without error
+func isAccepted(resp *http.Response) bool {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var msg struct {
+		Error json.RawMessage `json:"error"`
+	}
+	return json.Unmarshal(body, &msg) == nil && len(msg.Error) == 0
+}
+
+type stickySessionKey struct{}
+
+// stickySession : endpoint serving a consistency-sensitive sequence, per pool
+type stickySession struct {
+	mu        sync.Mutex
+	endpoints map[*EndpointPool]*endpoint
+}
+
+// StickyContext : requests under ctx stay on the endpoint that served the first of them, e.g. a receipt then its block.
+// The session moves to another endpoint only when its endpoint fails. Contexts already sticky are returned as is.
+func StickyContext(ctx context.Context) context.Context {
+	if stickySessionFrom(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, stickySessionKey{}, &stickySession{
+		endpoints: make(map[*EndpointPool]*endpoint),
+	})
+}
+
+func stickySessionFrom(ctx context.Context) *stickySession {
+	session, _ := ctx.Value(stickySessionKey{}).(*stickySession)
+	return session
+}
+
+func (session *stickySession) pinned(pool *EndpointPool) *endpoint {
+	if session == nil {
+		return nil
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.endpoints[pool]
+}
+
+func (session *stickySession) pin(pool *EndpointPool, endpoint *endpoint) {
+	if session == nil {
+		return
+	}
+	session.mu.Lock()
+	session.endpoints[pool] = endpoint
+	session.mu.Unlock()
+}
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testEndpoint : json-rpc endpoint at head, failing with status while status is set
+type testEndpoint struct {
+	*httptest.Server
+	head   uint64
+	status int32
+	calls  int32
+	sent   int32
+	reject bool
+}
+
+func newTestEndpoint(t *testing.T, head uint64) *testEndpoint {
+	endpoint := &testEndpoint{head: head}
+	endpoint.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&endpoint.calls, 1)
+		if status := atomic.LoadInt32(&endpoint.status); status != 0 {
+			w.WriteHeader(int(status))
+			return
+		}
+
+		var msg struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": msg.ID}
+		switch msg.Method {
+		case "eth_blockNumber":
+			resp["result"] = hexutil.Uint64(endpoint.head)
+		case "eth_sendRawTransaction":
+			atomic.AddInt32(&endpoint.sent, 1)
+			if endpoint.reject {
+				resp["error"] = map[string]interface{}{"code": -32000, "message": "already known"}
+			} else {
+				resp["result"] = "0x0000000000000000000000000000000000000000000000000000000000000001"
+			}
+		default:
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(endpoint.Close)
+	return endpoint
+}
+
+func newTestEndpointPool(t *testing.T, endpoints ...*testEndpoint) (*EndpointPool, *ethclient.Client) {
+	urls := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		urls = append(urls, endpoint.URL)
+	}
+
+	pool, err := NewEndpointPool(urls, NewLogger("test", DebugConfig{Level: DebugLevel}))
+	require.NoError(t, err)
+	pool.WithTimeout(time.Second)
+
+	client, err := pool.Dial()
+	require.NoError(t, err)
+	return pool, ethclient.NewClient(client)
+}
+
+func TestEndpointPool_Failover(t *testing.T) {
+	primary, fallback := newTestEndpoint(t, 100), newTestEndpoint(t, 99)
+	pool, client := newTestEndpointPool(t, primary, fallback)
+
+	head, err := client.BlockNumber(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), head)
+
+	// primary down, fallback serves and primary is skipped for the cooldown
+	atomic.StoreInt32(&primary.status, http.StatusBadGateway)
+	for i := 0; i < 3; i++ {
+		head, err = client.BlockNumber(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(99), head)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&primary.calls))
+
+	status := pool.Status()
+	assert.False(t, status[0].Healthy)
+	assert.ErrorContains(t, status[0].LastError, "502")
+	assert.True(t, status[1].Healthy)
+
+	// every endpoint down
+	atomic.StoreInt32(&fallback.status, http.StatusTooManyRequests)
+	_, err = client.BlockNumber(context.Background())
+	assert.ErrorContains(t, err, "429")
+
+	// recovered endpoints are healthy again after CheckHealth
+	atomic.StoreInt32(&primary.status, 0)
+	atomic.StoreInt32(&fallback.status, 0)
+	assert.NoError(t, pool.CheckHealth(context.Background()))
+	head, err = client.BlockNumber(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), head)
+}
+
+func TestEndpointPool_CheckHealth(t *testing.T) {
+	primary, fallback := newTestEndpoint(t, 100), newTestEndpoint(t, 200)
+	pool, client := newTestEndpointPool(t, primary, fallback)
+
+	// primary trails the fallback by more than DefaultMaxBlockLag
+	assert.NoError(t, pool.CheckHealth(context.Background()))
+	status := pool.Status()
+	assert.False(t, status[0].Healthy)
+	assert.ErrorContains(t, status[0].LastError, "trails")
+	assert.Equal(t, uint64(200), status[1].Head)
+
+	head, err := client.BlockNumber(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(200), head)
+
+	pool.WithMaxBlockLag(100)
+	assert.NoError(t, pool.CheckHealth(context.Background()))
+	assert.True(t, pool.Status()[0].Healthy)
+
+	atomic.StoreInt32(&primary.status, http.StatusServiceUnavailable)
+	atomic.StoreInt32(&fallback.status, http.StatusServiceUnavailable)
+	assert.ErrorContains(t, pool.CheckHealth(context.Background()), "no healthy endpoint")
+}
+
+func TestEndpointPool_Sticky(t *testing.T) {
+	primary, fallback := newTestEndpoint(t, 100), newTestEndpoint(t, 99)
+	_, client := newTestEndpointPool(t, primary, fallback)
+
+	// session pinned on the fallback while the primary was down
+	atomic.StoreInt32(&primary.status, http.StatusBadGateway)
+	ctx := StickyContext(context.Background())
+	head, err := client.BlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(99), head)
+	assert.Equal(t, ctx, StickyContext(ctx))
+
+	// stays there once the primary is back
+	atomic.StoreInt32(&primary.status, 0)
+	assertHead(t, client, ctx, 99)
+
+	// moves when its endpoint fails
+	atomic.StoreInt32(&fallback.status, http.StatusBadGateway)
+	assertHead(t, client, ctx, 100)
+	atomic.StoreInt32(&fallback.status, 0)
+	assertHead(t, client, ctx, 100)
+}
+
+func assertHead(t *testing.T, client *ethclient.Client, ctx context.Context, want uint64) {
+	head, err := client.BlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, want, head)
+}
+
+func TestEndpointPool_Broadcast(t *testing.T) {
+	primary, fallback, down := newTestEndpoint(t, 100), newTestEndpoint(t, 100), newTestEndpoint(t, 100)
+	primary.reject = true
+	atomic.StoreInt32(&down.status, http.StatusInternalServerError)
+	_, client := newTestEndpointPool(t, primary, fallback, down)
+
+	tx := ether.NewTx(&ether.LegacyTx{Gas: 21000})
+	assert.NoError(t, client.SendTransaction(context.Background(), tx))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&primary.sent))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fallback.sent))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&down.calls))
+
+	// rpc errors are returned when no endpoint accepts
+	fallback.reject = true
+	assert.ErrorContains(t, client.SendTransaction(context.Background(), tx), "already known")
+}
+
+func TestNewEndpointPool(t *testing.T) {
+	_, err := NewEndpointPool(nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewEndpointPool([]string{"http://localhost:8545", "ws://localhost:8546"}, nil)
+	assert.ErrorContains(t, err, "http and https")
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"math/big"
+)
+
+var ExitAlreadyProcessed = fmt.Errorf("exit already processed")
+
+// ExitPayload : rlp list of RootChainManager.exit, built by BuildPayloadForExit
+type ExitPayload struct {
+	HeaderNumber uint64
+	BlockProof   []byte
+	BlockNumber  uint64
+	BlockTime    uint64
+	TxRoot       common.Hash
+	ReceiptRoot  common.Hash
+	Receipt      []byte
+	ReceiptProof []byte
+	BranchMask   []byte
+	LogIndex     uint64
+}
+
+func DecodeExitPayload(payload []byte) (ExitPayload, error) {
+	var exitPayload ExitPayload
+	if err := rlp.DecodeBytes(payload, &exitPayload); err != nil {
+		return ExitPayload{}, fmt.Errorf("invalid exit payload: %w", err)
+	}
+
+	return exitPayload, nil
+}
+
+// ExitHash : key of processedExits
+func (exitPayload ExitPayload) ExitHash() common.Hash {
+	return ExitHash(exitPayload.BlockNumber, exitPayload.BranchMask, exitPayload.LogIndex)
+}
+
+// ExitHash : keccak256(abi.encodePacked(blockNumber, MerklePatriciaProof._getNibbleArray(branchMask), logIndex))
+// as computed by RootChainManager and FxBaseRootTunnel
+func ExitHash(blockNumber uint64, branchMask []byte, logIndex uint64) common.Hash {
+	return crypto.Keccak256Hash(
+		math.U256Bytes(new(big.Int).SetUint64(blockNumber)),
+		nibbleArray(branchMask),
+		math.U256Bytes(new(big.Int).SetUint64(logIndex)),
+	)
+}
+
+// nibbleArray : nibbles of hex prefix encoded path, one nibble per byte
+func nibbleArray(path []byte) []byte {
+	if len(path) == 0 {
+		return nil
+	}
+
+	nibble := func(n int) byte {
+		if n%2 == 0 {
+			return path[n/2] / 0x10
+		}
+		return path[n/2] % 0x10
+	}
+
+	var nibbles []byte
+	offset := 0
+	if hpNibble := nibble(0); hpNibble == 1 || hpNibble == 3 {
+		nibbles = make([]byte, len(path)*2-1)
+		nibbles[0] = nibble(1)
+		offset = 1
+	} else {
+		nibbles = make([]byte, len(path)*2-2)
+	}
+
+	for i := offset; i < len(nibbles); i++ {
+		nibbles[i] = nibble(i - offset + 2)
+	}
+	return nibbles
+}
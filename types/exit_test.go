@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestNibbleArray(t *testing.T) {
+	// even hex prefix, rlp(0) of tx index 0
+	assert.Equal(t, []byte{0x8, 0x0}, nibbleArray([]byte{0x00, 0x80}))
+	// even hex prefix, rlp(300)
+	assert.Equal(t, []byte{0x8, 0x2, 0x0, 0x1, 0x2, 0xc}, nibbleArray([]byte{0x00, 0x82, 0x01, 0x2c}))
+	// odd hex prefix keeps the second nibble
+	assert.Equal(t, []byte{0x3, 0xa, 0xb}, nibbleArray([]byte{0x13, 0xab}))
+	assert.Nil(t, nibbleArray(nil))
+}
+
+func TestExitPayload_ExitHash(t *testing.T) {
+	payload, err := rlp.EncodeToBytes(ExitPayload{
+		HeaderNumber: 10000,
+		BlockNumber:  29214440,
+		BranchMask:   []byte{0x00, 0x82, 0x01, 0x2c},
+		LogIndex:     1,
+	})
+	assert.NoError(t, err)
+
+	exitPayload, err := DecodeExitPayload(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(29214440), exitPayload.BlockNumber)
+
+	expected := crypto.Keccak256Hash(
+		common.LeftPadBytes(big.NewInt(29214440).Bytes(), 32),
+		[]byte{0x8, 0x2, 0x0, 0x1, 0x2, 0xc},
+		common.LeftPadBytes(big.NewInt(1).Bytes(), 32),
+	)
+	assert.Equal(t, expected, exitPayload.ExitHash())
+
+	_, err = DecodeExitPayload([]byte{0x01})
+	assert.Error(t, err)
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/params"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+const (
+	// DefaultBaseFeeMultiplier : fee cap headroom, 2x base fee survives six full blocks of base fee increase
+	DefaultBaseFeeMultiplier = 2.0
+	// FeeHistoryBlocks : number of recent blocks used for tip percentile
+	FeeHistoryBlocks = 10
+)
+
+// MinGasTipCaps : minimum priority fee accepted by the txpool of polygon pos chains, keyed by chain id
+var MinGasTipCaps = map[uint64]*big.Int{
+	137:   big.NewInt(30 * params.GWei),
+	80001: big.NewInt(30 * params.GWei),
+	80002: big.NewInt(30 * params.GWei),
+}
+
+// SuggestFees : GasTipCap and GasFeeCap of DynamicFeeTxType, unset values are computed from the latest block
+func (txOption *TxOption) SuggestFees(ctx context.Context, client IClient, chainId *big.Int) (*big.Int, *big.Int, error) {
+	var err error
+	gasTipCap := txOption.GasTipCap
+	if gasTipCap == nil {
+		gasTipCap, err = txOption.suggestGasTipCap(ctx, client, chainId)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	gasFeeCap := txOption.GasFeeCap
+	var baseFee *big.Int
+	if gasFeeCap == nil {
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		baseFee = header.BaseFee
+
+		multiplier := txOption.BaseFeeMultiplier
+		if multiplier == 0 {
+			multiplier = DefaultBaseFeeMultiplier
+		}
+		gasFeeCap = feeCap(baseFee, gasTipCap, multiplier)
+	}
+
+	if gasFeeCap.Cmp(gasTipCap) < 0 {
+		return nil, nil, fmt.Errorf("gas fee cap %s lower than gas tip cap %s", gasFeeCap, gasTipCap)
+	}
+
+	client.Logger().Debug("SuggestFees", log.Fields{
+		"baseFee":   baseFee,
+		"gasTipCap": gasTipCap,
+		"gasFeeCap": gasFeeCap,
+	})
+	return gasTipCap, gasFeeCap, nil
+}
+
+// suggestGasTipCap : eth_feeHistory percentile when TipPercentile is set, eth_maxPriorityFeePerGas otherwise,
+// raised to the minimum tip of the chain
+func (txOption *TxOption) suggestGasTipCap(ctx context.Context, client IClient, chainId *big.Int) (*big.Int, error) {
+	var gasTipCap *big.Int
+	if txOption.TipPercentile > 0 {
+		feeHistory, err := client.FeeHistory(ctx, FeeHistoryBlocks, nil, []float64{txOption.TipPercentile})
+		if err != nil {
+			return nil, err
+		}
+		gasTipCap = averageReward(feeHistory.Reward)
+	} else {
+		var err error
+		gasTipCap, err = client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	minGasTipCap := txOption.MinGasTipCap
+	if minGasTipCap == nil {
+		minGasTipCap = MinGasTipCaps[chainId.Uint64()]
+	}
+
+	if minGasTipCap != nil && gasTipCap.Cmp(minGasTipCap) < 0 {
+		gasTipCap = new(big.Int).Set(minGasTipCap)
+	}
+
+	return gasTipCap, nil
+}
+
+// feeCap : baseFee * multiplier + gasTipCap, gasTipCap before london
+func feeCap(baseFee, gasTipCap *big.Int, multiplier float64) *big.Int {
+	if baseFee == nil {
+		return new(big.Int).Set(gasTipCap)
+	}
+
+	headroom, _ := new(big.Float).Mul(new(big.Float).SetInt(baseFee), big.NewFloat(multiplier)).Int(nil)
+	return headroom.Add(headroom, gasTipCap)
+}
+
+// averageReward : average of the first percentile reward of each block
+func averageReward(rewards [][]*big.Int) *big.Int {
+	sum := new(big.Int)
+	count := int64(0)
+	for _, reward := range rewards {
+		if len(reward) == 0 || reward[0] == nil {
+			continue
+		}
+		sum.Add(sum, reward[0])
+		count++
+	}
+
+	if count == 0 {
+		return sum
+	}
+	return sum.Div(sum, big.NewInt(count))
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestFeeCap(t *testing.T) {
+	baseFee := big.NewInt(20 * params.GWei)
+	gasTipCap := big.NewInt(2 * params.GWei)
+
+	assert.Equal(t, big.NewInt(42*params.GWei), feeCap(baseFee, gasTipCap, DefaultBaseFeeMultiplier))
+	assert.Equal(t, big.NewInt(32*params.GWei), feeCap(baseFee, gasTipCap, 1.5))
+
+	// pre london
+	assert.Equal(t, gasTipCap, feeCap(nil, gasTipCap, DefaultBaseFeeMultiplier))
+}
+
+func TestAverageReward(t *testing.T) {
+	rewards := [][]*big.Int{
+		{big.NewInt(1 * params.GWei)},
+		{big.NewInt(3 * params.GWei)},
+		{},
+	}
+	assert.Equal(t, big.NewInt(2*params.GWei), averageReward(rewards))
+	assert.Equal(t, big.NewInt(0), averageReward(nil))
+}
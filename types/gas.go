@@ -0,0 +1,188 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+// DefaultGasMultiplier : safety margin on eth_estimateGas, state may change before the tx is mined
+const DefaultGasMultiplier = 1.2
+
+// DefaultGasCeilings : gas limit ceiling per contract method, estimates above the ceiling are refused
+var DefaultGasCeilings = map[string]uint64{
+	"approve":           200000,
+	"setApprovalForAll": 200000,
+	"depositEtherFor":   500000,
+	"depositFor":        1000000,
+	"withdraw":          500000,
+	"withdrawBatch":     2000000,
+	"exit":              5000000,
+	"receiveMessage":    5000000,
+}
+
+// EstimateGasError : eth_estimateGas failed, Reason is the decoded revert reason when the call reverted
+type EstimateGasError struct {
+	Method string
+	Reason string
+	Err    error
+}
+
+func (err *EstimateGasError) Error() string {
+	if err.Reason != "" {
+		return fmt.Sprintf("estimate gas %s: reverted: %s", err.Method, err.Reason)
+	}
+	return fmt.Sprintf("estimate gas %s: %s", err.Method, err.Err)
+}
+
+func (err *EstimateGasError) Unwrap() error { return err.Err }
+
+// EstimateGas : gas limit for the calldata of SetTxData, estimate times GasMultiplier capped by the method ceiling
+func (txOption *TxOption) EstimateGas(ctx context.Context, client IClient) (uint64, error) {
+	return txOption.estimateGas(ctx, client, txOption.AccessList)
+}
+
+func (txOption *TxOption) estimateGas(ctx context.Context, client IClient, accessList ether.AccessList) (uint64, error) {
+	signer := txOption.signer()
+	if signer == nil {
+		return 0, EmptySigner
+	}
+
+	method := maticabi.MethodName(txOption.data)
+	estimate, err := client.EstimateGas(ctx, ethereum.CallMsg{
+		From:       signer.Address(),
+		To:         &txOption.to,
+		Value:      txOption.value,
+		Data:       txOption.data,
+		AccessList: accessList,
+	})
+	if err != nil {
+		return 0, newEstimateGasError(method, err)
+	}
+
+	gasLimit, err := txOption.gasLimit(method, estimate)
+	if err != nil {
+		return 0, err
+	}
+
+	client.Logger().Debug("EstimateGas", log.Fields{
+		"method":   method,
+		"estimate": estimate,
+		"gas":      gasLimit,
+	})
+	return gasLimit, nil
+}
+
+func (txOption *TxOption) gasLimit(method string, estimate uint64) (uint64, error) {
+	multiplier := txOption.GasMultiplier
+	if multiplier == 0 {
+		multiplier = DefaultGasMultiplier
+	}
+	gasLimit := uint64(float64(estimate) * multiplier)
+
+	ceilings := txOption.GasCeilings
+	if ceilings == nil {
+		ceilings = DefaultGasCeilings
+	}
+
+	if ceiling, ok := ceilings[method]; ok {
+		if estimate > ceiling {
+			return 0, fmt.Errorf("estimated gas %d of %s exceeds ceiling %d", estimate, method, ceiling)
+		}
+
+		if gasLimit > ceiling {
+			gasLimit = ceiling
+		}
+	}
+
+	return gasLimit, nil
+}
+
+func newEstimateGasError(method string, err error) *EstimateGasError {
+	estimateGasError := &EstimateGasError{
+		Method: method,
+		Err:    err,
+	}
+
+	var dataError rpc.DataError
+	if !errors.As(err, &dataError) {
+		return estimateGasError
+	}
+
+	revertData, ok := dataError.ErrorData().(string)
+	if !ok {
+		return estimateGasError
+	}
+
+	data, decodeErr := hexutil.Decode(revertData)
+	if decodeErr != nil {
+		return estimateGasError
+	}
+
+	if reason, unpackErr := abi.UnpackRevert(data); unpackErr == nil {
+		estimateGasError.Reason = reason
+	}
+	return estimateGasError
+}
+
+// GasConfig : gas policy of transactions loaded with the client config, zero values keep the TxOption defaults
+type GasConfig struct {
+	TxType            int               `json:"txType" yaml:"txType"`
+	GasMultiplier     float64           `json:"gasMultiplier" yaml:"gasMultiplier"`
+	GasCeilings       map[string]uint64 `json:"gasCeilings" yaml:"gasCeilings"`
+	BaseFeeMultiplier float64           `json:"baseFeeMultiplier" yaml:"baseFeeMultiplier"`
+	TipPercentile     float64           `json:"tipPercentile" yaml:"tipPercentile"`
+	MinGasTipCap      *big.Int          `json:"minGasTipCap" yaml:"minGasTipCap"`
+	AutoAccessList    bool              `json:"autoAccessList" yaml:"autoAccessList"`
+}
+
+// TxOption : tx option signed by signer under the gas policy
+func (config GasConfig) TxOption(signer Signer) *TxOption {
+	txOption := &TxOption{
+		Signer:            signer,
+		TxType:            config.TxType,
+		GasMultiplier:     config.GasMultiplier,
+		BaseFeeMultiplier: config.BaseFeeMultiplier,
+		TipPercentile:     config.TipPercentile,
+		AutoAccessList:    config.AutoAccessList,
+	}
+
+	if config.GasCeilings != nil {
+		txOption.GasCeilings = make(map[string]uint64, len(config.GasCeilings))
+		for method, ceiling := range config.GasCeilings {
+			txOption.GasCeilings[method] = ceiling
+		}
+	}
+	if config.MinGasTipCap != nil {
+		txOption.MinGasTipCap = new(big.Int).Set(config.MinGasTipCap)
+	}
+
+	return txOption
+}
+
+// Validate : values the TxOption would refuse or misuse
+func (config GasConfig) Validate() error {
+	switch {
+	case config.TxType < LegacyTxType || config.TxType > DynamicFeeTxType:
+		return &ConfigError{Field: "Gas.TxType", Reason: fmt.Sprintf("unknown tx type %d", config.TxType)}
+	case config.GasMultiplier != 0 && config.GasMultiplier < 1:
+		return &ConfigError{Field: "Gas.GasMultiplier", Reason: fmt.Sprintf("%v below 1 underestimates gas", config.GasMultiplier)}
+	case config.BaseFeeMultiplier < 0:
+		return &ConfigError{Field: "Gas.BaseFeeMultiplier", Reason: fmt.Sprintf("negative multiplier %v", config.BaseFeeMultiplier)}
+	case config.TipPercentile < 0 || config.TipPercentile > 100:
+		return &ConfigError{Field: "Gas.TipPercentile", Reason: fmt.Sprintf("%v out of 0-100", config.TipPercentile)}
+	case config.MinGasTipCap != nil && config.MinGasTipCap.Sign() < 0:
+		return &ConfigError{Field: "Gas.MinGasTipCap", Reason: fmt.Sprintf("negative tip %s", config.MinGasTipCap)}
+	case config.AutoAccessList && config.TxType == LegacyTxType:
+		return &ConfigError{Field: "Gas.AutoAccessList", Reason: "access list not supported by LegacyTxType"}
+	}
+	return nil
+}
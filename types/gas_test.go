@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type revertError struct {
+	data string
+}
+
+func (err revertError) Error() string          { return "execution reverted" }
+func (err revertError) ErrorCode() int         { return 3 }
+func (err revertError) ErrorData() interface{} { return err.data }
+
+func TestTxOption_GasLimit(t *testing.T) {
+	txOption := &TxOption{}
+
+	gasLimit, err := txOption.gasLimit("transfer", 100000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(120000), gasLimit)
+
+	// capped by ceiling
+	gasLimit, err = txOption.gasLimit("approve", 190000)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultGasCeilings["approve"], gasLimit)
+
+	_, err = txOption.gasLimit("approve", 300000)
+	assert.Error(t, err)
+
+	txOption.GasMultiplier = 2
+	txOption.GasCeilings = map[string]uint64{"exit": 150000}
+	gasLimit, err = txOption.gasLimit("exit", 100000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(150000), gasLimit)
+}
+
+func TestNewEstimateGasError(t *testing.T) {
+	// Error("RootChainManager: EXIT_ALREADY_PROCESSED")
+	revertData := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000028" +
+		hexutil.Encode([]byte("RootChainManager: EXIT_ALREADY_PROCESSED"))[2:] +
+		"000000000000000000000000000000000000000000000000"
+
+	err := newEstimateGasError("exit", revertError{data: revertData})
+	assert.Equal(t, "RootChainManager: EXIT_ALREADY_PROCESSED", err.Reason)
+	assert.Equal(t, "estimate gas exit: reverted: RootChainManager: EXIT_ALREADY_PROCESSED", err.Error())
+
+	err = newEstimateGasError("exit", fmt.Errorf("insufficient funds"))
+	assert.Equal(t, "", err.Reason)
+	assert.ErrorContains(t, err, "insufficient funds")
+}
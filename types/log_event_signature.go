@@ -7,4 +7,9 @@ const (
 	ERC721BatchTransfer        = "0xf871896b17e9cb7a64941c62c188a4f5c621b86800e3d15452ece01ce56073df"
 	ERC1155BatchTransfer       = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
 	ERC721TransferWithMetadata = "0xf94915c6d1fd521cee85359239227480c7e8776d7caf1fc3bacad5c269b66a14"
-)
\ No newline at end of file
+	FxMessageSent              = "0x8c5261668696ce22758910d05bab8f186d6eb247ceac2af2e82c7dc17669b036"
+	PlasmaWithdraw             = "0xebff2602b3f468259e1e99f613fed6691f3a6526effe6ef3e768ba7ae7a36c4f"
+	PlasmaExitStarted          = "0xaa5303fdad123ab5ecaefaf69137bf8632257839546d43a3b3dd148cc2879d6f"
+	StateSynced                = "0x103fed9db65eac19c4d870f49ab7520fe03b99f1838e5996caf47e9e43308392"
+	NewHeaderBlock             = "0xba5de06d22af2685c6c7765f60067f7d2b08c2d29f53cdf14d67f6d1c9bfb527"
+)
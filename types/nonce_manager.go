@@ -0,0 +1,205 @@
+package types
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultNonceManager : shared by every root and child client of the process, accounts are keyed by chain id
+var DefaultNonceManager = NewNonceManager()
+
+type PendingNonceReader interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager : hands out sequential nonces per chain and address under concurrency
+type NonceManager struct {
+	mu       sync.Mutex
+	accounts map[nonceKey]*nonceAccount
+}
+
+type nonceKey struct {
+	chainId string
+	address common.Address
+}
+
+type nonceAccount struct {
+	mu     sync.Mutex
+	synced bool
+	next   uint64
+	issued map[uint64]struct{}
+	// gaps : released nonces below next, reused lowest first
+	gaps []uint64
+}
+
+func NewNonceManager() *NonceManager {
+	return &NonceManager{
+		accounts: make(map[nonceKey]*nonceAccount),
+	}
+}
+
+// Next : next nonce of address, synced from PendingNonceAt on first use and after Reset
+func (manager *NonceManager) Next(ctx context.Context, reader PendingNonceReader, chainId *big.Int, address common.Address) (uint64, error) {
+	account := manager.account(chainId, address)
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if !account.synced {
+		pending, err := reader.PendingNonceAt(ctx, address)
+		if err != nil {
+			return 0, err
+		}
+		account.next = pending
+		account.issued = make(map[uint64]struct{})
+		account.gaps = nil
+		account.synced = true
+	}
+
+	var nonce uint64
+	if len(account.gaps) > 0 {
+		nonce, account.gaps = account.gaps[0], account.gaps[1:]
+	} else {
+		nonce = account.next
+		account.next++
+	}
+	account.issued[nonce] = struct{}{}
+
+	return nonce, nil
+}
+
+// Release : give back nonce of a tx that was never sent, nonces not handed out by Next are ignored
+func (manager *NonceManager) Release(chainId *big.Int, address common.Address, nonce uint64) {
+	account := manager.account(chainId, address)
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if _, ok := account.issued[nonce]; !ok {
+		return
+	}
+	delete(account.issued, nonce)
+
+	account.gaps = append(account.gaps, nonce)
+	sort.Slice(account.gaps, func(i, j int) bool { return account.gaps[i] < account.gaps[j] })
+
+	// trailing gaps shrink next back
+	for len(account.gaps) > 0 && account.gaps[len(account.gaps)-1] == account.next-1 {
+		account.gaps = account.gaps[:len(account.gaps)-1]
+		account.next--
+	}
+}
+
+// Done : nonce is used by a sent tx. Nonces not handed out by Next, e.g. explicit TxOption.Nonce,
+// move next past them and leave the skipped ones as gaps
+func (manager *NonceManager) Done(chainId *big.Int, address common.Address, nonce uint64) {
+	account := manager.account(chainId, address)
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if _, ok := account.issued[nonce]; ok {
+		delete(account.issued, nonce)
+		return
+	}
+	if !account.synced {
+		return
+	}
+
+	for i, gap := range account.gaps {
+		if gap == nonce {
+			account.gaps = append(account.gaps[:i], account.gaps[i+1:]...)
+			return
+		}
+	}
+	for ; account.next < nonce; account.next++ {
+		account.gaps = append(account.gaps, account.next)
+	}
+	if account.next == nonce {
+		account.next++
+	}
+}
+
+// Reset : drop local state of address, next call of Next resyncs from PendingNonceAt
+func (manager *NonceManager) Reset(chainId *big.Int, address common.Address) {
+	account := manager.account(chainId, address)
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	account.synced = false
+}
+
+// Sent : track result of SendTransaction. The nonce is released only when the node rejected the tx,
+// any other failure, e.g. a timeout, may have reached the pool and resyncs from PendingNonceAt
+func (manager *NonceManager) Sent(tx *ether.Transaction, err error) {
+	chainId := tx.ChainId()
+	from, senderErr := ether.Sender(ether.LatestSignerForChainID(chainId), tx)
+	if senderErr != nil {
+		return
+	}
+
+	switch {
+	case err == nil:
+		manager.Done(chainId, from, tx.Nonce())
+	case IsRejectedError(err):
+		manager.Release(chainId, from, tx.Nonce())
+	default:
+		manager.Reset(chainId, from)
+	}
+}
+
+func (manager *NonceManager) account(chainId *big.Int, address common.Address) *nonceAccount {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	key := nonceKey{chainId: chainId.String(), address: address}
+	account, ok := manager.accounts[key]
+	if !ok {
+		account = &nonceAccount{}
+		manager.accounts[key] = account
+	}
+
+	return account
+}
+
+// IsNonceError : node rejected tx because local nonce is behind the account
+func IsNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "nonce too low") || strings.Contains(message, "already known")
+}
+
+// rejectedErrors : tx pool errors of a tx that never entered the pool, its nonce is still unused
+var rejectedErrors = []string{
+	"insufficient funds",
+	"intrinsic gas too low",
+	"transaction underpriced",
+	"max fee per gas less than block base fee",
+	"max priority fee per gas higher than max fee per gas",
+	"exceeds block gas limit",
+	"exceeds the configured cap",
+	"oversized data",
+	"negative value",
+	"invalid sender",
+	"transaction type not supported",
+}
+
+// IsRejectedError : node rejected tx before it reached the pool, nonce errors excluded
+func IsRejectedError(err error) bool {
+	if err == nil || IsNonceError(err) {
+		return false
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, rejected := range rejectedErrors {
+		if strings.Contains(message, rejected) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+)
+
+type pendingNonce uint64
+
+func (pending pendingNonce) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return uint64(pending), nil
+}
+
+func TestNonceManager_Next(t *testing.T) {
+	manager := NewNonceManager()
+	chainId := big.NewInt(80001)
+	address := common.HexToAddress("0x01")
+
+	var mu sync.Mutex
+	var nonces []uint64
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := manager.Next(context.Background(), pendingNonce(0), chainId, address)
+			assert.NoError(t, err)
+
+			mu.Lock()
+			nonces = append(nonces, nonce)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	for i, nonce := range nonces {
+		assert.Equal(t, uint64(i), nonce)
+	}
+
+	// accounts are separated by chain
+	nonce, err := manager.Next(context.Background(), pendingNonce(7), big.NewInt(5), address)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), nonce)
+}
+
+func TestNonceManager_Release(t *testing.T) {
+	manager := NewNonceManager()
+	chainId := big.NewInt(80001)
+	address := common.HexToAddress("0x01")
+	next := func() uint64 {
+		nonce, err := manager.Next(context.Background(), pendingNonce(3), chainId, address)
+		assert.NoError(t, err)
+		return nonce
+	}
+
+	assert.Equal(t, uint64(3), next())
+	assert.Equal(t, uint64(4), next())
+	assert.Equal(t, uint64(5), next())
+
+	// gap in the middle is reused first
+	manager.Release(chainId, address, 4)
+	assert.Equal(t, uint64(4), next())
+
+	// trailing nonce moves next back
+	manager.Release(chainId, address, 5)
+	assert.Equal(t, uint64(5), next())
+
+	// nonces not issued are ignored
+	manager.Done(chainId, address, 3)
+	manager.Release(chainId, address, 3)
+	assert.Equal(t, uint64(6), next())
+
+	manager.Reset(chainId, address)
+	assert.Equal(t, uint64(3), next())
+}
+
+func TestNonceManager_DoneExplicit(t *testing.T) {
+	manager := NewNonceManager()
+	chainId := big.NewInt(80001)
+	address := common.HexToAddress("0x01")
+	next := func() uint64 {
+		nonce, err := manager.Next(context.Background(), pendingNonce(3), chainId, address)
+		assert.NoError(t, err)
+		return nonce
+	}
+
+	// explicit nonces before sync are picked up by PendingNonceAt
+	manager.Done(chainId, address, 9)
+	assert.Equal(t, uint64(3), next())
+
+	// explicit next nonce is not handed out again
+	manager.Done(chainId, address, 4)
+	assert.Equal(t, uint64(5), next())
+
+	// skipped nonces are handed out before next
+	manager.Done(chainId, address, 8)
+	assert.Equal(t, uint64(6), next())
+	manager.Done(chainId, address, 7)
+	assert.Equal(t, uint64(9), next())
+}
+
+func TestNonceManager_Sent(t *testing.T) {
+	manager := NewNonceManager()
+	chainId := big.NewInt(80001)
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	send := func(pending uint64, sendErr error) uint64 {
+		nonce, err := manager.Next(context.Background(), pendingNonce(pending), chainId, address)
+		assert.NoError(t, err)
+
+		tx, err := ether.SignNewTx(key, ether.LatestSignerForChainID(chainId), &ether.LegacyTx{Nonce: nonce, Gas: 21000, GasPrice: big.NewInt(1)})
+		assert.NoError(t, err)
+		manager.Sent(tx, sendErr)
+		return nonce
+	}
+
+	assert.Equal(t, uint64(3), send(3, nil))
+
+	// rejected by the node, nonce is handed out again
+	assert.Equal(t, uint64(4), send(3, fmt.Errorf("insufficient funds for gas * price + value")))
+	assert.Equal(t, uint64(4), send(3, fmt.Errorf("transaction underpriced")))
+
+	// unknown outcome, nonce is not reused and the account resyncs
+	assert.Equal(t, uint64(4), send(3, fmt.Errorf("context deadline exceeded")))
+	assert.Equal(t, uint64(5), send(5, nil))
+	assert.Equal(t, uint64(6), send(5, fmt.Errorf("502 Bad Gateway")))
+	assert.Equal(t, uint64(7), send(7, nil))
+}
+
+func TestIsRejectedError(t *testing.T) {
+	assert.True(t, IsRejectedError(fmt.Errorf("insufficient funds for gas * price + value")))
+	assert.True(t, IsRejectedError(fmt.Errorf("intrinsic gas too low")))
+	assert.True(t, IsRejectedError(fmt.Errorf("replacement transaction underpriced")))
+	assert.False(t, IsRejectedError(fmt.Errorf("nonce too low")))
+	assert.False(t, IsRejectedError(fmt.Errorf("EOF")))
+	assert.False(t, IsRejectedError(nil))
+}
+
+func TestIsNonceError(t *testing.T) {
+	assert.True(t, IsNonceError(fmt.Errorf("nonce too low")))
+	assert.True(t, IsNonceError(fmt.Errorf("already known")))
+	assert.False(t, IsNonceError(fmt.Errorf("insufficient funds for gas * price + value")))
+	assert.False(t, IsNonceError(nil))
+}
@@ -1,9 +1,26 @@
 package types
 
-import "math/big"
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
 
+// RootBlockInfo : header block of RootChain, a checkpoint of child blocks [Start, End]
 type RootBlockInfo struct {
 	HeaderBlockNumber *big.Int
 	Start             *big.Int
 	End               *big.Int
+
+	// Root : merkle root of the checkpointed child block headers
+	Root common.Hash
+	// Proposer : validator who proposed the checkpoint
+	Proposer common.Address
+	// CreatedAt : root block timestamp of the checkpoint submission, nil in CheckpointWatcher notifications
+	CreatedAt *big.Int
+}
+
+// Contains : whether childBlockNumber is checkpointed by the header block
+func (info RootBlockInfo) Contains(childBlockNumber *big.Int) bool {
+	return info.Start != nil && info.End != nil &&
+		info.Start.Cmp(childBlockNumber) <= 0 && childBlockNumber.Cmp(info.End) <= 0
 }
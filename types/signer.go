@@ -0,0 +1,89 @@
+package types
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+)
+
+// Signer : signs transactions on behalf of TxOption, implementations may keep the key in memory,
+// in a keystore or behind a remote service
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *ether.Transaction, chainId *big.Int) (*ether.Transaction, error)
+	SignHash(ctx context.Context, hash common.Hash) ([]byte, error)
+}
+
+type PrivateKeySigner struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+// NewPrivateKeySigner : signer with in-memory ecdsa key
+func NewPrivateKeySigner(privateKey *ecdsa.PrivateKey) *PrivateKeySigner {
+	return &PrivateKeySigner{
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+}
+
+func (signer *PrivateKeySigner) Address() common.Address { return signer.address }
+
+func (signer *PrivateKeySigner) SignTx(ctx context.Context, tx *ether.Transaction, chainId *big.Int) (*ether.Transaction, error) {
+	return ether.SignTx(tx, ether.LatestSignerForChainID(chainId), signer.privateKey)
+}
+
+func (signer *PrivateKeySigner) SignHash(ctx context.Context, hash common.Hash) ([]byte, error) {
+	return crypto.Sign(hash.Bytes(), signer.privateKey)
+}
+
+// SignerConfig : reference to the signer of transactions, secrets stay in the environment variables it names.
+// At most one of PrivateKeyEnv, Keystore, MnemonicEnv and Remote is set.
+type SignerConfig struct {
+	// PrivateKeyEnv : environment variable holding the hex private key
+	PrivateKeyEnv string `json:"privateKeyEnv" yaml:"privateKeyEnv"`
+	// Keystore : geth keystore file, unlocked with the passphrase in PassphraseEnv
+	Keystore string `json:"keystore" yaml:"keystore"`
+	// MnemonicEnv : environment variable holding the BIP-39 mnemonic, password in PassphraseEnv
+	MnemonicEnv string `json:"mnemonicEnv" yaml:"mnemonicEnv"`
+	// DerivationPath : path of the mnemonic key, m/44'/60'/0'/0/0 when empty
+	DerivationPath string `json:"derivationPath" yaml:"derivationPath"`
+	// PassphraseEnv : environment variable holding the keystore passphrase or mnemonic password
+	PassphraseEnv string `json:"passphraseEnv" yaml:"passphraseEnv"`
+
+	// Remote : json-rpc endpoint of a remote signer holding the key of RemoteAddress
+	Remote        string         `json:"remote" yaml:"remote"`
+	RemoteAddress common.Address `json:"remoteAddress" yaml:"remoteAddress"`
+	// RemoteMethod : sign method of the remote signer, account_signTransaction when empty
+	RemoteMethod string `json:"remoteMethod" yaml:"remoteMethod"`
+}
+
+// Validate : one signer source at most, remote signers name their address
+func (config SignerConfig) Validate() error {
+	var sources []string
+	for _, source := range []struct {
+		field string
+		value string
+	}{
+		{"Signer.PrivateKeyEnv", config.PrivateKeyEnv},
+		{"Signer.Keystore", config.Keystore},
+		{"Signer.MnemonicEnv", config.MnemonicEnv},
+		{"Signer.Remote", config.Remote},
+	} {
+		if source.value != "" {
+			sources = append(sources, source.field)
+		}
+	}
+
+	if len(sources) > 1 {
+		return &ConfigError{Field: sources[1], Reason: fmt.Sprintf("conflicts with %s, one signer source at most", sources[0])}
+	}
+	if config.Remote != "" && config.RemoteAddress == (common.Address{}) {
+		return &ConfigError{Field: "Signer.RemoteAddress", Reason: "zero address"}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+type ValidatorStatus uint8
+
+const (
+	ValidatorInactive = ValidatorStatus(0)
+	ValidatorActive   = ValidatorStatus(1)
+	ValidatorLocked   = ValidatorStatus(2)
+	ValidatorUnstaked = ValidatorStatus(3)
+)
+
+func (status ValidatorStatus) String() string {
+	switch status {
+	case ValidatorInactive:
+		return "inactive"
+	case ValidatorActive:
+		return "active"
+	case ValidatorLocked:
+		return "locked"
+	case ValidatorUnstaked:
+		return "unstaked"
+	}
+
+	return ""
+}
+
+type Validator struct {
+	ValidatorId          *big.Int
+	Amount               *big.Int
+	Reward               *big.Int
+	ActivationEpoch      *big.Int
+	DeactivationEpoch    *big.Int
+	JailTime             *big.Int
+	Signer               common.Address
+	ContractAddress      common.Address
+	Status               ValidatorStatus
+	CommissionRate       *big.Int
+	LastCommissionUpdate *big.Int
+	DelegatorsReward     *big.Int
+	DelegatedAmount      *big.Int
+}
+
+// Unbond : pending sellVoucher_new of a delegator, claimable after withdrawEpoch + withdrawalDelay
+type Unbond struct {
+	Nonce         *big.Int
+	Shares        *big.Int
+	WithdrawEpoch *big.Int
+}
@@ -1,13 +1,23 @@
 package types
 
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
 type TokenType int
 
 const (
-	ERC20   = TokenType(1)
-	ERC721  = TokenType(2)
-	ERC1155 = TokenType(3)
+	ERC20           = TokenType(1)
+	ERC721          = TokenType(2)
+	ERC1155         = TokenType(3)
+	MintableERC20   = TokenType(4)
+	MintableERC721  = TokenType(5)
+	MintableERC1155 = TokenType(6)
 )
 
+var tokenTypes = []TokenType{ERC20, ERC721, ERC1155, MintableERC20, MintableERC721, MintableERC1155}
+
 func (token TokenType) String() string {
 	switch token {
 	case ERC20:
@@ -16,7 +26,67 @@ func (token TokenType) String() string {
 		return "erc721"
 	case ERC1155:
 		return "erc1155"
+	case MintableERC20:
+		return "mintable-erc20"
+	case MintableERC721:
+		return "mintable-erc721"
+	case MintableERC1155:
+		return "mintable-erc1155"
 	}
 
 	return ""
 }
+
+// Name : token type name registered on RootChainManager, e.g. "MintableERC20"
+func (token TokenType) Name() string {
+	switch token {
+	case ERC20:
+		return "ERC20"
+	case ERC721:
+		return "ERC721"
+	case ERC1155:
+		return "ERC1155"
+	case MintableERC20:
+		return "MintableERC20"
+	case MintableERC721:
+		return "MintableERC721"
+	case MintableERC1155:
+		return "MintableERC1155"
+	}
+
+	return ""
+}
+
+// Hash : keccak256(Name), the value returned by RootChainManager.tokenToType
+func (token TokenType) Hash() common.Hash {
+	return crypto.Keccak256Hash([]byte(token.Name()))
+}
+
+func (token TokenType) IsMintable() bool {
+	return token == MintableERC20 || token == MintableERC721 || token == MintableERC1155
+}
+
+// Standard : token standard of the type, mintable types resolve to the plain standard
+func (token TokenType) Standard() TokenType {
+	switch token {
+	case MintableERC20:
+		return ERC20
+	case MintableERC721:
+		return ERC721
+	case MintableERC1155:
+		return ERC1155
+	}
+
+	return token
+}
+
+// TokenTypeFromHash : resolve RootChainManager.tokenToType result, false when the hash is unknown
+func TokenTypeFromHash(hash common.Hash) (TokenType, bool) {
+	for _, tokenType := range tokenTypes {
+		if tokenType.Hash() == hash {
+			return tokenType, true
+		}
+	}
+
+	return 0, false
+}
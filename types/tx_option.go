@@ -7,7 +7,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ether "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	log "github.com/sirupsen/logrus"
 	"math/big"
 )
@@ -20,30 +19,57 @@ const (
 
 var (
 	EmptyPrivateKey = fmt.Errorf("empty private key")
+	EmptySigner     = fmt.Errorf("empty signer")
 	EmptyTxOption   = fmt.Errorf("tx option is nil")
 )
 
 type TxOption struct {
-	// PrivateKey required
+	// Signer required, or PrivateKey
+	Signer Signer
+
+	// PrivateKey : in-memory key, used when Signer is nil
 	PrivateKey *ecdsa.PrivateKey
 
 	// Optional Parameters
 	TxType int
 
-	// GasLimit : gas limit for tx
+	// GasLimit : gas limit for tx, estimated with eth_estimateGas when zero
 	GasLimit uint64
 
+	// GasMultiplier : multiplier on estimated gas, DefaultGasMultiplier when zero
+	GasMultiplier float64
+
+	// GasCeilings : gas limit ceiling per contract method name, DefaultGasCeilings when nil
+	GasCeilings map[string]uint64
+
 	// GasPrice : gas price for LegacyTxType
 	GasPrice *big.Int
 
 	// GasTipCap : maxPriorityFeePerGas for DynamicFeeTxType
 	GasTipCap *big.Int
 
-	// GasFeeCap : maxFeePerGas for DynamicFeeTxType
+	// GasFeeCap : maxFeePerGas for DynamicFeeTxType, base fee * BaseFeeMultiplier + GasTipCap when nil
 	GasFeeCap *big.Int
 
+	// BaseFeeMultiplier : headroom on latest base fee, DefaultBaseFeeMultiplier when zero
+	BaseFeeMultiplier float64
+
+	// TipPercentile : take GasTipCap from eth_feeHistory reward percentile, eth_maxPriorityFeePerGas when zero
+	TipPercentile float64
+
+	// MinGasTipCap : minimum of suggested GasTipCap, MinGasTipCaps of the chain when nil
+	MinGasTipCap *big.Int
+
+	// AccessList : EIP-2930 access list for AccessListTxType and DynamicFeeTxType
+	AccessList ether.AccessList
+
+	// AutoAccessList : attach access list from eth_createAccessList when AccessList is nil
+	AutoAccessList bool
+
 	ChainId *big.Int
-	Nonce   uint64
+
+	// Nonce : taken from the NonceManager of the client when nil, an explicit nonce is recorded in it once sent
+	Nonce *uint64
 
 	data  []byte
 	value *big.Int
@@ -63,109 +89,188 @@ func ValidateTxOption(txOption *TxOption) error {
 }
 
 func (txOption *TxOption) Validate() error {
-	if txOption.PrivateKey == nil {
-		return EmptyPrivateKey
+	if txOption.signer() == nil {
+		return EmptySigner
 	}
 
 	return nil
 }
 
+// SetTxData : copy of txOption with the tx target, calldata and value, txOption itself is left untouched
+// so concurrent sends can share it
 func (txOption *TxOption) SetTxData(to common.Address, data []byte, value *big.Int) *TxOption {
-	txOption.to = to
-	txOption.data = data
-	txOption.value = value
+	option := *txOption
+	option.to = to
+	option.data = data
+	option.value = value
 
-	return txOption
+	return &option
 }
 
 func (txOption *TxOption) From() common.Address {
-	return crypto.PubkeyToAddress(*txOption.PrivateKey.Public().(*ecdsa.PublicKey))
+	return txOption.signer().Address()
+}
+
+// signer : Signer if set, otherwise wraps PrivateKey without storing it, so a TxOption can be shared
+func (txOption *TxOption) signer() Signer {
+	if txOption.Signer != nil {
+		return txOption.Signer
+	}
+	if txOption.PrivateKey != nil {
+		return NewPrivateKeySigner(txOption.PrivateKey)
+	}
+	return nil
 }
 
 func (txOption *TxOption) Build(ctx context.Context, client IClient) (*ether.Transaction, error) {
 	var err error
-	if txOption.PrivateKey == nil {
-		return nil, fmt.Errorf("empty private key")
+	signer := txOption.signer()
+	if signer == nil {
+		return nil, EmptySigner
 	}
 
-	if txOption.ChainId == nil {
-		txOption.ChainId, err = client.ChainID(ctx)
+	chainId := txOption.ChainId
+	if chainId == nil {
+		chainId, err = client.ChainID(ctx)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if txOption.GasLimit == 0 {
-		txOption.GasLimit = 1e6
+	accessList := txOption.AccessList
+	if txOption.TxType == LegacyTxType && (accessList != nil || txOption.AutoAccessList) {
+		return nil, fmt.Errorf("access list not supported by LegacyTxType")
 	}
 
-	if txOption.Nonce == 0 {
-		txOption.Nonce, err = client.NonceAt(ctx, txOption.From(), nil)
+	if accessList == nil && txOption.AutoAccessList {
+		accessList, err = txOption.CreateAccessList(ctx, client)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	gasLimit := txOption.GasLimit
+	if gasLimit == 0 {
+		gasLimit, err = txOption.estimateGas(ctx, client, accessList)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var txData ether.TxData
+	var gasPrice, gasTipCap, gasFeeCap *big.Int
 	switch txOption.TxType {
 	case LegacyTxType:
-		if txOption.GasPrice == nil {
-			txOption.GasPrice, err = client.SuggestGasPrice(ctx)
+		gasPrice = txOption.GasPrice
+		if gasPrice == nil {
+			gasPrice, err = client.SuggestGasPrice(ctx)
 			if err != nil {
 				return nil, err
 			}
 		}
 
-		client.Logger().Debug("Sign Transaction", log.Fields{
-			"@type":    "LegacyTxType",
-			"signer":   txOption.From(),
-			"nonce":    txOption.Nonce,
-			"gasPrice": txOption.GasPrice,
-			"gas":      txOption.GasLimit,
-			"to":       txOption.to,
-			"value":    txOption.value,
-			"data":     hexutil.Encode(txOption.data),
-		})
-		return ether.SignNewTx(txOption.PrivateKey, ether.LatestSignerForChainID(txOption.ChainId), &ether.LegacyTx{
-			Nonce:    txOption.Nonce,
-			GasPrice: txOption.GasPrice,
-			Gas:      txOption.GasLimit,
+		txData = &ether.LegacyTx{
+			GasPrice: gasPrice,
+			Gas:      gasLimit,
 			To:       &txOption.to,
 			Value:    txOption.value,
 			Data:     txOption.data,
-		})
-	case DynamicFeeTxType:
-		if txOption.GasTipCap == nil {
-			txOption.GasTipCap, err = client.SuggestGasTipCap(ctx)
+		}
+	case AccessListTxType:
+		gasPrice = txOption.GasPrice
+		if gasPrice == nil {
+			gasPrice, err = client.SuggestGasPrice(ctx)
 			if err != nil {
 				return nil, err
 			}
 		}
 
-		if txOption.GasFeeCap == nil {
-			txOption.GasFeeCap = txOption.GasTipCap
+		txData = &ether.AccessListTx{
+			ChainID:    chainId,
+			GasPrice:   gasPrice,
+			Gas:        gasLimit,
+			To:         &txOption.to,
+			Value:      txOption.value,
+			Data:       txOption.data,
+			AccessList: accessList,
+		}
+	case DynamicFeeTxType:
+		gasTipCap, gasFeeCap, err = txOption.SuggestFees(ctx, client, chainId)
+		if err != nil {
+			return nil, err
+		}
+
+		txData = &ether.DynamicFeeTx{
+			ChainID:    chainId,
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        gasLimit,
+			To:         &txOption.to,
+			Value:      txOption.value,
+			Data:       txOption.data,
+			AccessList: accessList,
+		}
+	default:
+		return nil, fmt.Errorf("invalid tx type: %d", txOption.TxType)
+	}
+
+	// nonce from the manager is released when signing fails, and by SendTransaction of the client when sending fails
+	managed := txOption.Nonce == nil
+	var nonce uint64
+	if managed {
+		nonce, err = client.NonceManager().Next(ctx, client, chainId, signer.Address())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		nonce = *txOption.Nonce
+	}
+	setNonce(txData, nonce)
+
+	client.Logger().Debug("Sign Transaction", log.Fields{
+		"@type":      txTypeName(txOption.TxType),
+		"signer":     signer.Address(),
+		"nonce":      nonce,
+		"gasPrice":   gasPrice,
+		"gasTipCap":  gasTipCap,
+		"gasFeeCap":  gasFeeCap,
+		"gas":        gasLimit,
+		"to":         txOption.to,
+		"value":      txOption.value,
+		"data":       hexutil.Encode(txOption.data),
+		"accessList": len(accessList),
+	})
+
+	tx, err := signer.SignTx(ctx, ether.NewTx(txData), chainId)
+	if err != nil {
+		if managed {
+			client.NonceManager().Release(chainId, signer.Address(), nonce)
 		}
+		return nil, err
+	}
+
+	return tx, nil
+}
 
-		client.Logger().Debug("Sign Transaction", log.Fields{
-			"@type":     "DynamicFeeTxType",
-			"signer":    txOption.From(),
-			"nonce":     txOption.Nonce,
-			"gasTipCap": txOption.GasTipCap,
-			"gasFeeCap": txOption.GasFeeCap,
-			"gas":       txOption.GasLimit,
-			"to":        txOption.to,
-			"value":     txOption.value,
-			"data":      hexutil.Encode(txOption.data),
-		})
-		return ether.SignNewTx(txOption.PrivateKey, ether.LatestSignerForChainID(txOption.ChainId), &ether.DynamicFeeTx{
-			ChainID:   txOption.ChainId,
-			Nonce:     txOption.Nonce,
-			GasTipCap: txOption.GasTipCap,
-			GasFeeCap: txOption.GasFeeCap,
-			Gas:       txOption.GasLimit,
-			To:        &txOption.to,
-			Value:     txOption.value,
-			Data:      txOption.data,
-		})
+func setNonce(txData ether.TxData, nonce uint64) {
+	switch txData := txData.(type) {
+	case *ether.LegacyTx:
+		txData.Nonce = nonce
+	case *ether.AccessListTx:
+		txData.Nonce = nonce
+	case *ether.DynamicFeeTx:
+		txData.Nonce = nonce
+	}
+}
+
+func txTypeName(txType int) string {
+	switch txType {
+	case LegacyTxType:
+		return "LegacyTxType"
+	case AccessListTxType:
+		return "AccessListTxType"
+	case DynamicFeeTxType:
+		return "DynamicFeeTxType"
 	}
-	return nil, fmt.Errorf("invalid tx type: %d", txOption.TxType)
+	return fmt.Sprintf("TxType(%d)", txType)
 }
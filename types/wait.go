@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"time"
+)
+
+// DefaultPollInterval : receipt polling interval of WaitMined and WaitConfirmed
+const DefaultPollInterval = 3 * time.Second
+
+var (
+	TxDropped  = fmt.Errorf("tx dropped")
+	TxReplaced = fmt.Errorf("tx replaced")
+)
+
+// TxFailedError : tx is mined with failed receipt status
+type TxFailedError struct {
+	TxHash  common.Hash
+	Receipt *ether.Receipt
+}
+
+func (err *TxFailedError) Error() string {
+	return fmt.Sprintf("tx failed: %s in block %s", err.TxHash, err.Receipt.BlockNumber)
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"sync"
+)
+
+// BatchConcurrency : json-rpc batch requests in flight at once
+var BatchConcurrency = 4
+
+// batchCall : send elems in batches of batchSize, at most BatchConcurrency batches in flight.
+// Errors of single elements are left in elems, the first transport error is returned.
+func batchCall(ctx context.Context, client types.IClient, elems []rpc.BatchElem, batchSize int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	concurrency := BatchConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	sem := make(chan struct{}, concurrency)
+	for start := 0; start < len(elems); start += batchSize {
+		end := start + batchSize
+		if end > len(elems) {
+			end = len(elems)
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			fail(ctx.Err())
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(batch []rpc.BatchElem) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			client.Logger().Debug("BatchCallContext", log.Fields{
+				"method": batch[0].Method,
+				"size":   len(batch),
+			})
+
+			if err := client.Rpc().BatchCallContext(ctx, batch); err != nil {
+				fail(err)
+			}
+		}(elems[start:end])
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// IsMethodNotFound : whether err is the json-rpc error of a method the node does not serve
+func IsMethodNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601 {
+		return true
+	}
+
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "method not found") ||
+		strings.Contains(message, "does not exist/is not available") ||
+		strings.Contains(message, "method not supported")
+}
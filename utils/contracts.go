@@ -1,27 +1,118 @@
 package utils
 
 import (
+	"embed"
 	"encoding/json"
+	"fmt"
 	"github.com/MinseokOh/matic-sdk-go/types"
+	"sort"
+	"sync"
 )
 
-func GetContractByNetwork(network types.Network) types.Contract {
-	var response string
-	var err error
-	switch network {
-	case types.TestNet:
-		response, err = Get(types.TestNetContractURL, nil, nil)
-	case types.MainNet:
-		response, err = Get(types.MainNetContractURL, nil, nil)
+//go:embed networks/*.json
+var networks embed.FS
+
+// networkFiles : embedded address registries, only networks whose registry is complete for every client
+var networkFiles = map[types.Network]string{
+	types.MainNet: "networks/mainnet.json",
+}
+
+// networkURLs : remote address registry of each supported network
+var networkURLs = map[types.Network]string{
+	types.MainNet: types.MainNetContractURL,
+	types.TestNet: types.TestNetContractURL,
+	types.Amoy:    types.AmoyContractURL,
+}
+
+var (
+	customNetworksMu sync.RWMutex
+	customNetworks   = make(map[types.Network]types.Contract)
+	// fetchedNetworks : published registries of networks which are not embedded, fetched once per process
+	fetchedNetworks = make(map[types.Network]types.Contract)
+)
+
+// RegisterNetwork : add a custom network, e.g. a local devnet, or replace the registry of a supported one.
+// NewDefaultConfig of every client resolves network from it afterwards.
+func RegisterNetwork(network types.Network, contract types.Contract) error {
+	if err := contract.Validate(); err != nil {
+		return fmt.Errorf("network %d: %w", network, err)
+	}
+
+	customNetworksMu.Lock()
+	defer customNetworksMu.Unlock()
+	customNetworks[network] = contract
+	return nil
+}
+
+// EmbeddedNetworks : networks whose address registry is embedded in the module, sorted by chain id
+func EmbeddedNetworks() []types.Network {
+	embedded := make([]types.Network, 0, len(networkFiles))
+	for network := range networkFiles {
+		embedded = append(embedded, network)
+	}
+	sort.Slice(embedded, func(i, j int) bool { return embedded[i] < embedded[j] })
+	return embedded
+}
+
+// GetContractByNetwork : registered or embedded address registry of network, which works offline.
+// Other supported networks are fetched from their published registry once, an error for unsupported networks.
+func GetContractByNetwork(network types.Network) (types.Contract, error) {
+	customNetworksMu.RLock()
+	contract, ok := customNetworks[network]
+	if !ok {
+		contract, ok = fetchedNetworks[network]
+	}
+	customNetworksMu.RUnlock()
+	if ok {
+		return contract, nil
 	}
+
+	file, ok := networkFiles[network]
+	if !ok {
+		contract, err := FetchContractByNetwork(network)
+		if err != nil {
+			return types.Contract{}, err
+		}
+
+		customNetworksMu.Lock()
+		fetchedNetworks[network] = contract
+		customNetworksMu.Unlock()
+		return contract, nil
+	}
+
+	raw, err := networks.ReadFile(file)
 	if err != nil {
-		return types.Contract{}
+		return types.Contract{}, err
+	}
+
+	if err := json.Unmarshal(raw, &contract); err != nil {
+		return types.Contract{}, fmt.Errorf("registry %s: %w", file, err)
+	}
+
+	return contract, nil
+}
+
+// FetchContractByNetwork : latest address registry of network from static.matic.network,
+// an error when it can not be fetched or lacks addresses required by the pos client
+func FetchContractByNetwork(network types.Network) (types.Contract, error) {
+	url, ok := networkURLs[network]
+	if !ok {
+		return types.Contract{}, fmt.Errorf("unsupported network: %d", network)
+	}
+
+	response, err := Get(url, nil, nil)
+	if err != nil {
+		return types.Contract{}, fmt.Errorf("registry %s: %w", url, err)
 	}
 
 	var contract types.Contract
-	if err = json.Unmarshal([]byte(response), &contract); err != nil {
-		return types.Contract{}
+	if err := json.Unmarshal([]byte(response), &contract); err != nil {
+		return types.Contract{}, fmt.Errorf("registry %s: %w", url, err)
+	}
+
+	if err := contract.Validate(); err != nil {
+		return types.Contract{}, fmt.Errorf("registry %s: %w", url, err)
 	}
 
-	return contract
+	return contract, nil
 }
@@ -0,0 +1,49 @@
+package utils_test
+
+import (
+	"github.com/MinseokOh/matic-sdk-go/fx"
+	"github.com/MinseokOh/matic-sdk-go/heimdall"
+	"github.com/MinseokOh/matic-sdk-go/plasma"
+	"github.com/MinseokOh/matic-sdk-go/pos"
+	"github.com/MinseokOh/matic-sdk-go/staking"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/MinseokOh/matic-sdk-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// TestEmbeddedNetworks : every embedded registry builds a valid config for every client
+func TestEmbeddedNetworks(t *testing.T) {
+	networks := utils.EmbeddedNetworks()
+	require.NotEmpty(t, networks)
+
+	for _, network := range networks {
+		contract, err := utils.GetContractByNetwork(network)
+		require.NoError(t, err)
+		assert.NoError(t, contract.Validate(), "network %d", network)
+
+		assert.NoError(t, pos.NewDefaultConfig(network).Validate(), "pos network %d", network)
+
+		fxConfig, err := fx.NewDefaultConfig(network)
+		require.NoError(t, err)
+		assert.NoError(t, fxConfig.Validate(), "fx network %d", network)
+
+		plasmaConfig, err := plasma.NewDefaultConfig(network)
+		require.NoError(t, err)
+		assert.NoError(t, plasmaConfig.Validate(), "plasma network %d", network)
+
+		stakingConfig, err := staking.NewDefaultConfig(network)
+		require.NoError(t, err)
+		assert.NoError(t, stakingConfig.Validate(), "staking network %d", network)
+
+		heimdallConfig, err := heimdall.NewDefaultConfig(network)
+		require.NoError(t, err)
+		assert.NotEmpty(t, heimdallConfig.API, "heimdall network %d", network)
+	}
+}
+
+func TestGetContractByNetwork_Unsupported(t *testing.T) {
+	_, err := utils.GetContractByNetwork(types.Network(4242))
+	assert.ErrorContains(t, err, "unsupported network")
+}
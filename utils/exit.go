@@ -2,63 +2,137 @@ package utils
 
 import (
 	"context"
+	"fmt"
 	"github.com/MinseokOh/matic-sdk-go/types"
 	maticabi "github.com/MinseokOh/matic-sdk-go/types/abi"
 	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
 	"math/big"
 )
 
 var (
-	bigOne             = big.NewInt(1)
-	bigTwo             = big.NewInt(2)
-	checkPointInterval = big.NewInt(10000)
+	bigOne = big.NewInt(1)
+	bigTwo = big.NewInt(2)
 )
 
+// FindRootBlockFromChild : header block number of rootChain checkpointing childBlockNumber, with DefaultCheckpointInterval
 func FindRootBlockFromChild(ctx context.Context, client types.IClient, childBlockNumber *big.Int, rootChain common.Address) (*big.Int, error) {
+	headerBlock, err := FindHeaderBlock(ctx, client, nil, types.RootConfig{RootChain: rootChain}, childBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return headerBlock.HeaderBlockNumber, nil
+}
+
+// FindHeaderBlock : header block of RootChain of config checkpointing childBlockNumber,
+// looked up in cache first and binary searched over all checkpoints otherwise. cache may be nil.
+func FindHeaderBlock(ctx context.Context, client types.IClient, cache *types.CheckpointCache, config types.RootConfig, childBlockNumber *big.Int) (types.RootBlockInfo, error) {
+	rootChain := config.RootChain
+	checkPointInterval := config.GetCheckpointInterval()
+
+	chainId, err := cacheChainId(ctx, client, cache)
+	if err != nil {
+		return types.RootBlockInfo{}, err
+	}
+	if cache != nil {
+		if headerBlock, ok := cache.Find(chainId, rootChain, childBlockNumber); ok {
+			client.Logger().Debug("FindHeaderBlock", log.Fields{
+				"childBlockNumber": childBlockNumber,
+				"headerBlock":      headerBlock.HeaderBlockNumber,
+				"cached":           true,
+			})
+			return headerBlock, nil
+		}
+	}
+
 	currentHeaderBlockResp, err := CallContract(ctx, client, rootChain, maticabi.RootChain,
 		"currentHeaderBlock",
 	)
 	if err != nil {
-		return nil, err
+		return types.RootBlockInfo{}, err
 	}
 	currentHeaderBlock := currentHeaderBlockResp[0].(*big.Int)
 
-	// first checkpoint id = start * 10000
-	start := bigOne
-	// last checkpoint id = end * 10000
+	// first checkpoint id = start * checkPointInterval
+	start := new(big.Int).Set(bigOne)
+	// last checkpoint id = end * checkPointInterval
 	end := new(big.Int).Div(currentHeaderBlock, checkPointInterval)
 
 	// binary search on all the checkpoints to find the checkpoint that contains the childBlockNumber
-	var ans *big.Int
-	for start.Cmp(end) != 0 {
+	for start.Cmp(end) <= 0 {
 		mid := new(big.Int).Div(new(big.Int).Add(start, end), bigTwo)
 
-		headerBlocksResp, err := CallContract(ctx, client, rootChain, maticabi.RootChain,
-			"headerBlocks",
-			new(big.Int).Mul(mid, checkPointInterval),
-		)
+		headerBlock, err := getHeaderBlock(ctx, client, cache, chainId, new(big.Int).Mul(mid, checkPointInterval), rootChain)
 		if err != nil {
-			return nil, err
+			return types.RootBlockInfo{}, err
 		}
 
-		headerStart := headerBlocksResp[1].(*big.Int)
-		headerEnd := headerBlocksResp[2].(*big.Int)
-
-		if headerStart.Cmp(childBlockNumber) == -1 && childBlockNumber.Cmp(headerEnd) == -1 {
-			// if childBlockNumber is between the upper and lower bounds of the headerBlock, we found our answer
-			ans = mid
-			break
-		} else if headerStart.Cmp(childBlockNumber) == 1 {
+		if headerBlock.Contains(childBlockNumber) {
+			return headerBlock, nil
+		} else if headerBlock.Start.Cmp(childBlockNumber) == 1 {
 			// childBlockNumber was checkpointed before this header
 			end = mid.Sub(mid, bigOne)
-		} else if headerStart.Cmp(childBlockNumber) == -1 {
+		} else {
 			// childBlockNumber was checkpointed after this header
 			start = mid.Add(mid, bigOne)
 		}
 	}
-	if start.Cmp(end) == 0 {
-		ans = start
+
+	return types.RootBlockInfo{}, fmt.Errorf("child block %s not checkpointed up to header block %s", childBlockNumber, currentHeaderBlock)
+}
+
+// GetHeaderBlock : headerBlocks of rootChain, served from cache when present and cached once submitted. cache may be nil.
+func GetHeaderBlock(ctx context.Context, client types.IClient, cache *types.CheckpointCache, headerBlockNumber *big.Int, rootChain common.Address) (types.RootBlockInfo, error) {
+	chainId, err := cacheChainId(ctx, client, cache)
+	if err != nil {
+		return types.RootBlockInfo{}, err
+	}
+
+	return getHeaderBlock(ctx, client, cache, chainId, headerBlockNumber, rootChain)
+}
+
+// cacheChainId : chain id of client keying cache entries, nil without cache
+func cacheChainId(ctx context.Context, client types.IClient, cache *types.CheckpointCache) (*big.Int, error) {
+	if cache == nil {
+		return nil, nil
+	}
+
+	return client.ChainID(ctx)
+}
+
+func getHeaderBlock(ctx context.Context, client types.IClient, cache *types.CheckpointCache, chainId, headerBlockNumber *big.Int, rootChain common.Address) (types.RootBlockInfo, error) {
+	if cache != nil {
+		if headerBlock, ok := cache.Get(chainId, rootChain, headerBlockNumber); ok {
+			return headerBlock, nil
+		}
+	}
+
+	// headerBlocks(uint256) returns (bytes32 root, uint256 start, uint256 end, uint256 createdAt, address proposer)
+	headerBlocksResp, err := CallContract(ctx, client, rootChain, maticabi.RootChain,
+		"headerBlocks",
+		headerBlockNumber,
+	)
+	if err != nil {
+		return types.RootBlockInfo{}, err
+	}
+
+	headerBlock := types.RootBlockInfo{
+		HeaderBlockNumber: new(big.Int).Set(headerBlockNumber),
+		Root:              common.Hash(headerBlocksResp[0].([32]byte)),
+		Start:             headerBlocksResp[1].(*big.Int),
+		End:               headerBlocksResp[2].(*big.Int),
+		CreatedAt:         headerBlocksResp[3].(*big.Int),
+		Proposer:          headerBlocksResp[4].(common.Address),
+	}
+
+	if cache != nil {
+		if err := cache.Put(chainId, rootChain, headerBlock); err != nil {
+			client.Logger().Error("CheckpointCache", log.Fields{
+				"error": err.Error(),
+			})
+		}
 	}
 
-	return new(big.Int).Mul(ans, checkPointInterval), nil
+	return headerBlock, nil
 }
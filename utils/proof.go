@@ -25,13 +25,16 @@ var (
 	}
 )
 
-func BuildBlockProof(ctx context.Context, client types.IClient, txBlockNumber, startBlock, endBlock *big.Int) ([]byte, error) {
+// BuildBlockProof : proof of txBlockNumber in the checkpoint of blocks [startBlock, endBlock],
+// sub tree root hashes are taken from source
+func BuildBlockProof(ctx context.Context, client types.IClient, source types.RootHashSource, txBlockNumber, startBlock, endBlock *big.Int) ([]byte, error) {
 	client.Logger().Debug("BuildBlockProof", log.Fields{
 		"txBlockNumber": txBlockNumber,
 		"start":         startBlock,
 		"end":           endBlock,
+		"source":        source,
 	})
-	proof, err := getFastMerkleProof(ctx, client, txBlockNumber, startBlock, endBlock)
+	proof, err := getFastMerkleProof(ctx, client, source, txBlockNumber, startBlock, endBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +50,7 @@ func BuildBlockProof(ctx context.Context, client types.IClient, txBlockNumber, s
 	return buf, nil
 }
 
-func getFastMerkleProof(ctx context.Context, client types.IClient, txBlockNumber, startBlock, endBlock *big.Int) ([]common.Hash, error) {
+func getFastMerkleProof(ctx context.Context, client types.IClient, source types.RootHashSource, txBlockNumber, startBlock, endBlock *big.Int) ([]common.Hash, error) {
 	start := startBlock.Int64()
 	end := endBlock.Int64()
 	blockNumber := txBlockNumber.Int64()
@@ -68,7 +71,7 @@ func getFastMerkleProof(ctx context.Context, client types.IClient, txBlockNumber
 
 		if targetIndex > pivotLeaf {
 			newLeftBound := pivotLeaf + 1
-			subTreeMerkleRoot, err := queryRootHash(ctx, client, offset+leftBound, offset+pivotLeaf)
+			subTreeMerkleRoot, err := queryRootHash(ctx, client, &source, offset+leftBound, offset+pivotLeaf)
 			if err != nil {
 				return nil, err
 			}
@@ -87,7 +90,7 @@ func getFastMerkleProof(ctx context.Context, client types.IClient, txBlockNumber
 
 				heightDifference := expectedHeight - subTreeHeight
 
-				remainingNodesHash, err := queryRootHash(ctx, client, offset+pivotLeaf+1, offset+rightBound)
+				remainingNodesHash, err := queryRootHash(ctx, client, &source, offset+pivotLeaf+1, offset+rightBound)
 				if err != nil {
 					return nil, err
 				}
@@ -124,26 +127,6 @@ func reverseProof(proofs []common.Hash) []common.Hash {
 	return reversed
 }
 
-func queryRootHash(ctx context.Context, client types.IClient, startBlock, endBlock int64) (common.Hash, error) {
-	var payload interface{}
-	err := client.Rpc().CallContext(ctx, &payload, "eth_getRootHash",
-		big.NewInt(startBlock),
-		big.NewInt(endBlock),
-	)
-
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	client.Logger().Debug("queryRootHash", log.Fields{
-		"start": startBlock,
-		"end":   endBlock,
-		"hash":  common.HexToHash(payload.(string)),
-	})
-
-	return common.HexToHash(payload.(string)), nil
-}
-
 func recursiveZeroHash(n int64) common.Hash {
 	if n == 0 {
 		return common.Hash{}
@@ -154,27 +137,19 @@ func recursiveZeroHash(n int64) common.Hash {
 }
 
 func GetReceiptProof(ctx context.Context, client types.IClient, txReceipt *ether.Receipt, block *ether.Block) ([]byte, []byte, error) {
+	ctx = types.StickyContext(ctx)
 	client.Logger().Debug("GetReceiptProof", log.Fields{
 		"txReceipt": txReceipt.TxHash.String(),
 		"block":     block.NumberU64(),
 	})
 
-	stateSyncTxHash := getStateSyncTxHash(block)
-	receiptsTrie := trie.NewTrie()
-	for _, tx := range block.Transactions() {
-		if tx.Hash() == stateSyncTxHash {
-			continue
-		}
-
-		client.Logger().Debug("TransactionReceipt", log.Fields{
-			"txHash": tx.Hash().String(),
-		})
-
-		receipt, err := client.TransactionReceipt(ctx, tx.Hash())
-		if err != nil {
-			continue
-		}
+	receipts, err := GetBlockReceipts(ctx, client, block)
+	if err != nil {
+		return nil, nil, err
+	}
 
+	receiptsTrie := trie.NewTrie()
+	for _, receipt := range receipts {
 		raw, err := receipt.MarshalBinary()
 		if err != nil {
 			return nil, nil, err
@@ -187,6 +162,10 @@ func GetReceiptProof(ctx context.Context, client types.IClient, txReceipt *ether
 		receiptsTrie.Put(path, raw)
 	}
 
+	if receiptRoot := common.BytesToHash(receiptsTrie.Hash()); receiptRoot != block.ReceiptHash() {
+		return nil, nil, fmt.Errorf("receipts root mismatch of block %d: computed %s, header %s", block.NumberU64(), receiptRoot, block.ReceiptHash())
+	}
+
 	path, err := rlp.EncodeToBytes(txReceipt.TransactionIndex)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+)
+
+// ReceiptBatchSize : receipts per json-rpc batch request when no block receipts method is available
+var ReceiptBatchSize = 100
+
+// blockReceiptsMethods : single call methods returning all receipts of a block, geth first then bor
+var blockReceiptsMethods = []string{
+	"eth_getBlockReceipts",
+	"eth_getTransactionReceiptsByBlock",
+}
+
+// GetBlockReceipts : receipts of the txs in block, in block order, without the bor state sync receipt.
+// Fetched with one block receipts call, or with batched eth_getTransactionReceipt when the node has none.
+func GetBlockReceipts(ctx context.Context, client types.IClient, block *ether.Block) ([]*ether.Receipt, error) {
+	stateSyncTxHash := getStateSyncTxHash(block)
+	txHashes := make([]common.Hash, 0, len(block.Transactions()))
+	for _, tx := range block.Transactions() {
+		if tx.Hash() == stateSyncTxHash {
+			continue
+		}
+		txHashes = append(txHashes, tx.Hash())
+	}
+
+	for _, method := range blockReceiptsMethods {
+		client.Logger().Debug("GetBlockReceipts", log.Fields{
+			"method": method,
+			"block":  block.NumberU64(),
+		})
+
+		var receipts []*ether.Receipt
+		err := client.Rpc().CallContext(ctx, &receipts, method, hexutil.EncodeBig(block.Number()))
+		if IsMethodNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s of block %d: %w", method, block.NumberU64(), err)
+		}
+		if receipts == nil {
+			return nil, fmt.Errorf("%s of block %d: %w", method, block.NumberU64(), ethereum.NotFound)
+		}
+
+		return orderReceipts(block, txHashes, receipts)
+	}
+
+	return batchReceipts(ctx, client, block, txHashes)
+}
+
+// orderReceipts : receipts of txHashes in order, receipts of other txs are dropped
+func orderReceipts(block *ether.Block, txHashes []common.Hash, receipts []*ether.Receipt) ([]*ether.Receipt, error) {
+	byHash := make(map[common.Hash]*ether.Receipt, len(receipts))
+	for _, receipt := range receipts {
+		if receipt != nil {
+			byHash[receipt.TxHash] = receipt
+		}
+	}
+
+	ordered := make([]*ether.Receipt, len(txHashes))
+	for i, txHash := range txHashes {
+		receipt, ok := byHash[txHash]
+		if !ok {
+			return nil, fmt.Errorf("receipt of %s in block %d: %w", txHash, block.NumberU64(), ethereum.NotFound)
+		}
+		ordered[i] = receipt
+	}
+
+	return ordered, nil
+}
+
+// batchReceipts : eth_getTransactionReceipt of txHashes in batches of ReceiptBatchSize
+func batchReceipts(ctx context.Context, client types.IClient, block *ether.Block, txHashes []common.Hash) ([]*ether.Receipt, error) {
+	receipts := make([]*ether.Receipt, len(txHashes))
+	batch := make([]rpc.BatchElem, len(txHashes))
+	for i, txHash := range txHashes {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{txHash},
+			Result: &receipts[i],
+		}
+	}
+
+	if err := batchCall(ctx, client, batch, ReceiptBatchSize); err != nil {
+		return nil, fmt.Errorf("receipts of block %d: %w", block.NumberU64(), err)
+	}
+
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("receipt of %s in block %d: %w", txHashes[i], block.NumberU64(), elem.Error)
+		}
+		if receipts[i] == nil {
+			return nil, fmt.Errorf("receipt of %s in block %d: %w", txHashes[i], block.NumberU64(), ethereum.NotFound)
+		}
+	}
+
+	return receipts, nil
+}
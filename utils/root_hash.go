@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+)
+
+// HeaderBatchSize : headers per json-rpc batch request of LocalRootHash
+var HeaderBatchSize = 100
+
+// queryRootHash : root hash of blocks [startBlock, endBlock] from source,
+// source turns RootHashLocal once RootHashAuto falls back so later queries skip the rpc
+func queryRootHash(ctx context.Context, client types.IClient, source *types.RootHashSource, startBlock, endBlock int64) (common.Hash, error) {
+	if *source != types.RootHashLocal {
+		rootHash, err := rpcRootHash(ctx, client, startBlock, endBlock)
+		if err == nil || *source == types.RootHashRPC {
+			return rootHash, err
+		}
+		if !IsMethodNotFound(err) && !errors.Is(err, ethereum.NotFound) {
+			return common.Hash{}, err
+		}
+
+		client.Logger().Debug("queryRootHash", log.Fields{
+			"fallback": "local",
+			"reason":   err.Error(),
+		})
+		*source = types.RootHashLocal
+	}
+
+	return LocalRootHash(ctx, client, startBlock, endBlock)
+}
+
+// rpcRootHash : bor eth_getRootHash, ethereum.NotFound on a null result
+func rpcRootHash(ctx context.Context, client types.IClient, startBlock, endBlock int64) (common.Hash, error) {
+	var payload *string
+	err := client.Rpc().CallContext(ctx, &payload, "eth_getRootHash",
+		big.NewInt(startBlock),
+		big.NewInt(endBlock),
+	)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("eth_getRootHash of blocks %d-%d: %w", startBlock, endBlock, err)
+	}
+	if payload == nil {
+		return common.Hash{}, fmt.Errorf("eth_getRootHash of blocks %d-%d: %w", startBlock, endBlock, ethereum.NotFound)
+	}
+
+	rootHash := common.HexToHash(*payload)
+	client.Logger().Debug("queryRootHash", log.Fields{
+		"start": startBlock,
+		"end":   endBlock,
+		"hash":  rootHash,
+	})
+
+	return rootHash, nil
+}
+
+// LocalRootHash : root hash of blocks [startBlock, endBlock] as computed by bor eth_getRootHash,
+// merkle root of BlockProofLeaf of each header, headers fetched in batches of HeaderBatchSize
+func LocalRootHash(ctx context.Context, client types.IClient, startBlock, endBlock int64) (common.Hash, error) {
+	if startBlock < 0 || endBlock < startBlock {
+		return common.Hash{}, fmt.Errorf("invalid block range: %d-%d", startBlock, endBlock)
+	}
+
+	headers := make([]*ether.Header, endBlock-startBlock+1)
+	batch := make([]rpc.BatchElem, len(headers))
+	for i := range headers {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeUint64(uint64(startBlock) + uint64(i)), false},
+			Result: &headers[i],
+		}
+	}
+
+	if err := batchCall(ctx, client, batch, HeaderBatchSize); err != nil {
+		return common.Hash{}, fmt.Errorf("headers of blocks %d-%d: %w", startBlock, endBlock, err)
+	}
+
+	leaves := make([]common.Hash, len(headers))
+	for i, header := range headers {
+		blockNumber := startBlock + int64(i)
+		if batch[i].Error != nil {
+			return common.Hash{}, fmt.Errorf("header of block %d: %w", blockNumber, batch[i].Error)
+		}
+		if header == nil {
+			return common.Hash{}, fmt.Errorf("header of block %d: %w", blockNumber, ethereum.NotFound)
+		}
+
+		leaves[i] = BlockProofLeaf(header.Number.Uint64(), header.Time, header.TxHash, header.ReceiptHash)
+	}
+
+	merkleTree, err := NewMerkleTree(leaves)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	rootHash := merkleTree.GetRoot()
+	client.Logger().Debug("LocalRootHash", log.Fields{
+		"start": startBlock,
+		"end":   endBlock,
+		"hash":  rootHash,
+	})
+
+	return rootHash, nil
+}
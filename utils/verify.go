@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	ethtrie "github.com/ethereum/go-ethereum/trie"
+	"math/big"
+)
+
+// VerifyReceiptProof : value at path of receipts trie with root, proof is the rlp list of parent nodes built by GetReceiptProof
+func VerifyReceiptProof(receiptRoot common.Hash, path []byte, proof []byte) ([]byte, error) {
+	var parentNodes [][][]byte
+	if err := rlp.DecodeBytes(proof, &parentNodes); err != nil {
+		return nil, fmt.Errorf("invalid receipt proof: %w", err)
+	}
+
+	proofDb := memorydb.New()
+	for _, parentNode := range parentNodes {
+		node, err := rlp.EncodeToBytes(parentNode)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := proofDb.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := ethtrie.VerifyProof(receiptRoot, path, proofDb)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receipt proof: %w", err)
+	}
+
+	if value == nil {
+		return nil, fmt.Errorf("invalid receipt proof: path %x not in receipts root %s", path, receiptRoot)
+	}
+	return value, nil
+}
+
+// BlockProofLeaf : leaf of the checkpoint merkle tree, keccak256(abi.encodePacked(number, time, txRoot, receiptRoot))
+func BlockProofLeaf(blockNumber, blockTime uint64, txRoot, receiptRoot common.Hash) common.Hash {
+	return crypto.Keccak256Hash(
+		math.U256Bytes(new(big.Int).SetUint64(blockNumber)),
+		math.U256Bytes(new(big.Int).SetUint64(blockTime)),
+		txRoot.Bytes(),
+		receiptRoot.Bytes(),
+	)
+}
+
+// VerifyBlockProof : Merkle.checkMembership of RootChain, proof is the concatenated sibling hashes built by BuildBlockProof
+func VerifyBlockProof(leaf common.Hash, index uint64, root common.Hash, proof []byte) error {
+	if len(proof)%32 != 0 {
+		return fmt.Errorf("invalid block proof length: %d", len(proof))
+	}
+
+	height := len(proof) / 32
+	if height < 64 && index >= uint64(1)<<height {
+		return fmt.Errorf("invalid block proof: index %d out of tree of height %d", index, height)
+	}
+
+	computed := leaf.Bytes()
+	for i := 0; i < len(proof); i += 32 {
+		sibling := proof[i : i+32]
+		if index%2 == 0 {
+			computed = crypto.Keccak256(computed, sibling)
+		} else {
+			computed = crypto.Keccak256(sibling, computed)
+		}
+		index /= 2
+	}
+
+	if !bytes.Equal(computed, root.Bytes()) {
+		return fmt.Errorf("invalid block proof: computed root %s, checkpoint root %s", common.BytesToHash(computed), root)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/MinseokOh/matic-sdk-go/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ether "github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// WaitMined : WaitConfirmed with one confirmation
+func WaitMined(ctx context.Context, client types.IClient, txHash common.Hash, pollInterval time.Duration) (*ether.Receipt, error) {
+	return WaitConfirmed(ctx, client, txHash, 1, pollInterval)
+}
+
+// WaitConfirmed : poll until the receipt of tx is buried under confirmations blocks, the mined block counts as one.
+// A tx that was seen and then left the pool is reported as TxReplaced when its nonce is used, TxDropped otherwise.
+// Failed receipt status is reported as *types.TxFailedError with the receipt.
+func WaitConfirmed(ctx context.Context, client types.IClient, txHash common.Hash, confirmations uint64, pollInterval time.Duration) (*ether.Receipt, error) {
+	// receipt and head of one endpoint, a lagging fallback would undo confirmations
+	ctx = types.StickyContext(ctx)
+	client.Logger().Debug("WaitConfirmed", log.Fields{
+		"txHash":        txHash,
+		"confirmations": confirmations,
+	})
+
+	if pollInterval <= 0 {
+		pollInterval = types.DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var pending *ether.Transaction
+	for {
+		receipt, err := client.TransactionReceipt(ctx, txHash)
+		switch {
+		case err == nil:
+			confirmed, err := isConfirmed(ctx, client, receipt, confirmations)
+			if err != nil {
+				return nil, err
+			}
+
+			if confirmed {
+				if receipt.Status == ether.ReceiptStatusFailed {
+					return receipt, &types.TxFailedError{TxHash: txHash, Receipt: receipt}
+				}
+
+				client.Logger().Debug("WaitConfirmed", log.Fields{
+					"txHash":      txHash,
+					"blockNumber": receipt.BlockNumber,
+				})
+				return receipt, nil
+			}
+		case errors.Is(err, ethereum.NotFound):
+			pending, err = checkPending(ctx, client, txHash, pending)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func isConfirmed(ctx context.Context, client types.IClient, receipt *ether.Receipt, confirmations uint64) (bool, error) {
+	if confirmations <= 1 {
+		return true, nil
+	}
+
+	blockNumber, err := client.BlockNumber(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return blockNumber+1 >= receipt.BlockNumber.Uint64()+confirmations, nil
+}
+
+// checkPending : tx of txHash while it is known to the node, error when a seen tx has left the pool without receipt
+func checkPending(ctx context.Context, client types.IClient, txHash common.Hash, seen *ether.Transaction) (*ether.Transaction, error) {
+	tx, _, err := client.TransactionByHash(ctx, txHash)
+	if err == nil {
+		return tx, nil
+	}
+
+	if !errors.Is(err, ethereum.NotFound) {
+		return nil, err
+	}
+
+	// not propagated yet
+	if seen == nil {
+		return nil, nil
+	}
+
+	// mined between the receipt and the tx lookup
+	if _, err := client.TransactionReceipt(ctx, txHash); err == nil {
+		return seen, nil
+	}
+
+	sender, err := ether.Sender(ether.LatestSignerForChainID(seen.ChainId()), seen)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := client.NonceAt(ctx, sender, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if nonce > seen.Nonce() {
+		return nil, fmt.Errorf("%w: %s nonce %d", types.TxReplaced, txHash, seen.Nonce())
+	}
+	return nil, fmt.Errorf("%w: %s", types.TxDropped, txHash)
+}